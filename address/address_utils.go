@@ -3,9 +3,14 @@ package address
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"net"
+	"strconv"
+	"strings"
 )
 
 func IncAddressBy1(toInc []byte) []byte {
@@ -58,6 +63,50 @@ func MacBytesToString(mac []byte) string {
 	return net.HardwareAddr(mac).String()
 }
 
+//Parses the 3 bytes organizationally unique identifier (OUI) prefixing mac addresses, with ':' or '-' separators
+func MacOuiStringToBytes(oui string) ([]byte, error) {
+	separator := ":"
+	if strings.Contains(oui, "-") {
+		separator = "-"
+	}
+
+	parts := strings.Split(oui, separator)
+	if len(parts) != 3 {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid oui", oui))
+	}
+
+	byteRepr := []byte{}
+	for _, part := range parts {
+		partBytes, err := hex.DecodeString(part)
+		if err != nil || len(partBytes) != 1 {
+			return []byte{}, errors.New(fmt.Sprintf("%s is not a valid oui", oui))
+		}
+
+		byteRepr = append(byteRepr, partBytes[0])
+	}
+
+	return byteRepr, nil
+}
+
+func MacOuiBytesToString(oui []byte) string {
+	return fmt.Sprintf("%02x:%02x:%02x", oui[0], oui[1], oui[2])
+}
+
+//First and last mac addresses with the oui as their prefix
+func MacOuiBoundaries(oui []byte) ([]byte, []byte) {
+	return append(append([]byte{}, oui...), 0x00, 0x00, 0x00), append(append([]byte{}, oui...), 0xff, 0xff, 0xff)
+}
+
+//The I/G bit (least significant bit of the first byte) is unset for unicast addresses
+func MacIsUnicast(mac []byte) bool {
+	return mac[0] & 0x01 == 0
+}
+
+//The U/L bit (second least significant bit of the first byte) is set for locally administered addresses
+func MacIsLocallyAdministered(mac []byte) bool {
+	return mac[0] & 0x02 != 0
+}
+
 func AddressWithinBoundaries(addr []byte, lower []byte, higher []byte) bool {
 	lowerRangeCmp := bytes.Compare(addr, lower)
 	upperRangeCmp := bytes.Compare(addr, higher)
@@ -78,9 +127,14 @@ func Ipv4RangeAddressCount(firstAddr []byte, lastAddr []byte) int64 {
 	return int64(binary.BigEndian.Uint32(Ipv4BytesTo4(lastAddr))) - int64(binary.BigEndian.Uint32(Ipv4BytesTo4(firstAddr))) + int64(1)
 }
 
+//Mac addresses can be 8 (EUI-64) or 20 (IP over InfiniBand) bytes long, so their count can exceed an int64 and saturates
+func MacRangeAddressCount(firstAddr []byte, lastAddr []byte) int64 {
+	return SaturatedAddressRangeSize(firstAddr, lastAddr)
+}
+
 func Ipv6StringToBytes(ipv6 string) ([]byte, error) {
 	byteRepr := net.ParseIP(ipv6)
-	if byteRepr == nil || byteRepr.To16() == nil {
+	if byteRepr == nil || byteRepr.To16() == nil || byteRepr.To4() != nil {
 		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid ipv6 address", ipv6))
 	}
 
@@ -89,4 +143,176 @@ func Ipv6StringToBytes(ipv6 string) ([]byte, error) {
 
 func Ipv6BytesToString(ipv6 []byte) string {
 	return net.IP(ipv6).String()
-}
\ No newline at end of file
+}
+
+//Saturates at the maximum int64 value for ranges holding more addresses than that
+func Ipv6RangeAddressCount(firstAddr []byte, lastAddr []byte) int64 {
+	return SaturatedAddressRangeSize(firstAddr, lastAddr)
+}
+func Ipv4CidrStringToBytes(cidr string) ([]byte, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid ipv4 cidr", cidr))
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid ipv4 cidr: Address has bits set outside of the prefix", cidr))
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	return append([]byte(ip.To16()), byte(prefixLength)), nil
+}
+
+func Ipv4CidrBytesToString(cidr []byte) string {
+	return fmt.Sprintf("%s/%d", Ipv4BytesToString(cidr[:len(cidr)-1]), cidr[len(cidr)-1])
+}
+
+func Ipv4CidrBoundaries(cidr []byte) ([]byte, []byte) {
+	firstAddr := net.IP(cidr[:len(cidr)-1]).To4()
+	mask := net.CIDRMask(int(cidr[len(cidr)-1]), 32)
+	lastAddr := make(net.IP, len(firstAddr))
+	for idx := range firstAddr {
+		lastAddr[idx] = firstAddr[idx] | ^mask[idx]
+	}
+
+	return []byte(firstAddr.To16()), []byte(lastAddr.To16())
+}
+
+//Excludes the network and broadcast addresses, except for /31 and /32 cidrs which don't have any
+func Ipv4CidrHostBoundaries(cidr []byte) ([]byte, []byte) {
+	firstAddr, lastAddr := Ipv4CidrBoundaries(cidr)
+	if cidr[len(cidr)-1] >= 31 {
+		return firstAddr, lastAddr
+	}
+
+	size := AddressRangeSize(firstAddr, lastAddr)
+	return IncAddressBy1(firstAddr), AddressAtOffset(firstAddr, size.Sub(size, big.NewInt(2)))
+}
+
+func Ipv4CidrNetmask(cidr []byte) string {
+	return net.IP(net.CIDRMask(int(cidr[len(cidr)-1]), 32)).String()
+}
+
+func Ipv4CidrLessThan(cidr []byte, addr []byte) bool {
+	return AddressLessThan(cidr[:len(cidr)-1], addr)
+}
+
+func Ipv4BoundariesToCidr(firstAddr []byte, lastAddr []byte) ([]byte, error) {
+	count := Ipv4RangeAddressCount(firstAddr, lastAddr)
+	prefixLength := 32
+	for count > 1 && count % 2 == 0 {
+		count = count / 2
+		prefixLength -= 1
+	}
+
+	cidr := append([]byte(net.IP(firstAddr).To16()), byte(prefixLength))
+	cidrFirstAddr, cidrLastAddr := Ipv4CidrBoundaries(cidr)
+	if count != 1 || !bytes.Equal(Ipv4BytesTo4(cidrFirstAddr), Ipv4BytesTo4(firstAddr)) || !bytes.Equal(Ipv4BytesTo4(cidrLastAddr), Ipv4BytesTo4(lastAddr)) {
+		return []byte{}, errors.New(fmt.Sprintf("Range %s-%s does not match a cidr", Ipv4BytesToString(firstAddr), Ipv4BytesToString(lastAddr)))
+	}
+
+	return cidr, nil
+}
+//Integers are encoded with an extra bit so that the value following the largest integer of the bit width can be represented
+func IntegerByteLength(bitWidth int) int {
+	return bitWidth / 8 + 1
+}
+
+func IntegerToBytes(value int64, bitWidth int) ([]byte, error) {
+	if value < 0 || (bitWidth < 63 && value >= int64(1) << bitWidth) {
+		return []byte{}, errors.New(fmt.Sprintf("%d is not a valid integer of %d bits", value, bitWidth))
+	}
+
+	byteRepr := make([]byte, 8)
+	binary.BigEndian.PutUint64(byteRepr, uint64(value))
+	return byteRepr[8 - IntegerByteLength(bitWidth):], nil
+}
+
+func IntegerBytesToInt64(value []byte) int64 {
+	byteRepr := make([]byte, 8)
+	copy(byteRepr[8 - len(value):], value)
+	return int64(binary.BigEndian.Uint64(byteRepr))
+}
+
+func IntegerBytesToString(value []byte) string {
+	return strconv.FormatInt(IntegerBytesToInt64(value), 10)
+}
+
+func IntegerRangeAddressCount(firstAddr []byte, lastAddr []byte) int64 {
+	return IntegerBytesToInt64(lastAddr) - IntegerBytesToInt64(firstAddr) + int64(1)
+}
+
+func AddressRangeSize(firstAddr []byte, lastAddr []byte) *big.Int {
+	size := new(big.Int).Sub(new(big.Int).SetBytes(lastAddr), new(big.Int).SetBytes(firstAddr))
+	return size.Add(size, big.NewInt(1))
+}
+
+//Same as AddressRangeSize, saturating at the maximum int64 value for ranges holding more addresses than that
+func SaturatedAddressRangeSize(firstAddr []byte, lastAddr []byte) int64 {
+	size := AddressRangeSize(firstAddr, lastAddr)
+	if !size.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return size.Int64()
+}
+
+//Returns the address the given offset after the first address, with the same byte length as the first address
+func AddressAtOffset(firstAddr []byte, offset *big.Int) []byte {
+	addr := new(big.Int).Add(new(big.Int).SetBytes(firstAddr), offset)
+	return addr.FillBytes(make([]byte, len(firstAddr)))
+}
+
+/*
+	Returns the ipv6 address with its last 32 bits replaced by the host bits of the ipv4 address, which are the bits
+	past the ipv4 prefix length
+*/
+func Ipv4HostBitsToIpv6(ipv6 []byte, ipv4 []byte, ipv4PrefixLength int) []byte {
+	hostMask := uint32(0)
+	if ipv4PrefixLength < 32 {
+		hostMask = math.MaxUint32 >> ipv4PrefixLength
+	}
+
+	derived := make([]byte, 16)
+	copy(derived, net.IP(ipv6).To16())
+	binary.BigEndian.PutUint32(derived[12:], binary.BigEndian.Uint32(Ipv4BytesTo4(ipv4)) & hostMask)
+	return derived
+}
+
+//Parses an ipv6 /64 prefix in cidr notation into the 16 bytes of its network address
+func Ipv6Prefix64StringToBytes(prefix string) ([]byte, error) {
+	ip, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil || ip.To4() != nil {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid ipv6 prefix", prefix))
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	if prefixLength != 64 {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a valid ipv6 prefix: Prefix length must be 64", prefix))
+	}
+
+	return []byte(ipNet.IP.To16()), nil
+}
+
+/*
+	Returns the address a host with the given 48 bits mac address autoconfigures (SLAAC) in the ipv6 /64 prefix:
+	the interface identifier is the modified EUI-64 of the mac address, which is ff:fe inserted in the middle of the
+	mac address, with the universal/local bit of its first byte flipped
+*/
+func MacToEui64Ipv6(prefix []byte, mac []byte) ([]byte, error) {
+	if len(mac) != 6 {
+		return []byte{}, errors.New(fmt.Sprintf("%s is not a 48 bits mac address", MacBytesToString(mac)))
+	}
+
+	derived := make([]byte, 16)
+	copy(derived, net.IP(prefix).To16()[:8])
+	derived[8] = mac[0] ^ 0x02
+	derived[9] = mac[1]
+	derived[10] = mac[2]
+	derived[11] = 0xff
+	derived[12] = 0xfe
+	derived[13] = mac[3]
+	derived[14] = mac[4]
+	derived[15] = mac[5]
+	return derived, nil
+}
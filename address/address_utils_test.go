@@ -1,6 +1,9 @@
 package address
 
 import (
+	"bytes"
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -34,4 +37,399 @@ func TestIpv4RangeAddressCount(t *testing.T) {
 	if range2Count != expectedRange2Count {
 		t.Errorf("Expected range count between address 3 and address 4 to be %d and it was %d", expectedRange2Count, range2Count)
 	}
-}
\ No newline at end of file
+}
+func TestIpv6RangeAddressCount(t *testing.T) {
+	addr1, addr1Err := Ipv6StringToBytes("fd00:10:128::be")
+	if addr1Err != nil {
+		t.Errorf("Address range count test failed getting address 1: %s", addr1Err.Error())
+	}
+
+	addr2, addr2Err := Ipv6StringToBytes("fd00:10:128::1:fe")
+	if addr2Err != nil {
+		t.Errorf("Address range count test failed getting address 2: %s", addr2Err.Error())
+	}
+
+	range1Count := Ipv6RangeAddressCount(addr1, addr2)
+	expectedRange1Count := int64(65) + int64(65536)
+	if range1Count != expectedRange1Count {
+		t.Errorf("Expected range count between address 1 and address 2 to be %d and it was %d", expectedRange1Count, range1Count)
+	}
+
+	addr3, addr3Err := Ipv6StringToBytes("fd00:10:128::")
+	if addr3Err != nil {
+		t.Errorf("Address range count test failed getting address 3: %s", addr3Err.Error())
+	}
+
+	addr4, addr4Err := Ipv6StringToBytes("fd00:10:128:0:ffff:ffff:ffff:ffff")
+	if addr4Err != nil {
+		t.Errorf("Address range count test failed getting address 4: %s", addr4Err.Error())
+	}
+
+	range2Count := Ipv6RangeAddressCount(addr3, addr4)
+	if range2Count != math.MaxInt64 {
+		t.Errorf("Expected range count between address 3 and address 4 to saturate at %d and it was %d", int64(math.MaxInt64), range2Count)
+	}
+
+	_, ipv4Err := Ipv6StringToBytes("10.128.60.190")
+	if ipv4Err == nil {
+		t.Errorf("Expected ipv4 address to be rejected as an ipv6 address")
+	}
+}
+
+
+func TestMacRangeAddressCount(t *testing.T) {
+	addr1, addr1Err := MacStringToBytes("52:54:00:00:00:00")
+	if addr1Err != nil {
+		t.Errorf("Address range count test failed getting address 1: %s", addr1Err.Error())
+	}
+
+	addr2, addr2Err := MacStringToBytes("52:54:00:00:01:ff")
+	if addr2Err != nil {
+		t.Errorf("Address range count test failed getting address 2: %s", addr2Err.Error())
+	}
+
+	range1Count := MacRangeAddressCount(addr1, addr2)
+	if range1Count != int64(512) {
+		t.Errorf("Expected range count between address 1 and address 2 to be 512 and it was %d", range1Count)
+	}
+
+	addr3, addr3Err := MacStringToBytes("00:00:00:00:00:00")
+	if addr3Err != nil {
+		t.Errorf("Address range count test failed getting address 3: %s", addr3Err.Error())
+	}
+
+	addr4, addr4Err := MacStringToBytes("ff:ff:ff:ff:ff:ff")
+	if addr4Err != nil {
+		t.Errorf("Address range count test failed getting address 4: %s", addr4Err.Error())
+	}
+
+	range2Count := MacRangeAddressCount(addr3, addr4)
+	if range2Count != int64(1) << 48 {
+		t.Errorf("Expected range count between address 3 and address 4 to be %d and it was %d", int64(1) << 48, range2Count)
+	}
+
+	addr5, addr5Err := MacStringToBytes("f0:00:00:00:00:00:00:00")
+	if addr5Err != nil {
+		t.Errorf("Address range count test failed getting address 5: %s", addr5Err.Error())
+	}
+
+	addr6, addr6Err := MacStringToBytes("f0:00:00:00:00:00:01:ff")
+	if addr6Err != nil {
+		t.Errorf("Address range count test failed getting address 6: %s", addr6Err.Error())
+	}
+
+	range3Count := MacRangeAddressCount(addr5, addr6)
+	if range3Count != int64(512) {
+		t.Errorf("Expected range count between address 5 and address 6 to be 512 and it was %d", range3Count)
+	}
+
+	addr7, addr7Err := MacStringToBytes("00:00:00:00:00:00:00:00")
+	if addr7Err != nil {
+		t.Errorf("Address range count test failed getting address 7: %s", addr7Err.Error())
+	}
+
+	addr8, addr8Err := MacStringToBytes("ff:ff:ff:ff:ff:ff:ff:ff")
+	if addr8Err != nil {
+		t.Errorf("Address range count test failed getting address 8: %s", addr8Err.Error())
+	}
+
+	range4Count := MacRangeAddressCount(addr7, addr8)
+	if range4Count != math.MaxInt64 {
+		t.Errorf("Expected range count between address 7 and address 8 to saturate at %d and it was %d", int64(math.MaxInt64), range4Count)
+	}
+
+	addr9, addr9Err := MacStringToBytes("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01")
+	if addr9Err != nil {
+		t.Errorf("Address range count test failed getting address 9: %s", addr9Err.Error())
+	}
+
+	addr10, addr10Err := MacStringToBytes("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:01:00")
+	if addr10Err != nil {
+		t.Errorf("Address range count test failed getting address 10: %s", addr10Err.Error())
+	}
+
+	range5Count := MacRangeAddressCount(addr9, addr10)
+	if range5Count != int64(256) {
+		t.Errorf("Expected range count between address 9 and address 10 to be 256 and it was %d", range5Count)
+	}
+
+	addr11, addr11Err := MacStringToBytes("ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff:ff")
+	if addr11Err != nil {
+		t.Errorf("Address range count test failed getting address 11: %s", addr11Err.Error())
+	}
+
+	range6Count := MacRangeAddressCount(addr9, addr11)
+	if range6Count != math.MaxInt64 {
+		t.Errorf("Expected range count between address 9 and address 11 to saturate at %d and it was %d", int64(math.MaxInt64), range6Count)
+	}
+}
+
+func TestIpv4Cidr(t *testing.T) {
+	cidr, cidrErr := Ipv4CidrStringToBytes("10.128.0.0/16")
+	if cidrErr != nil {
+		t.Errorf("Cidr test failed parsing cidr: %s", cidrErr.Error())
+	}
+
+	if Ipv4CidrBytesToString(cidr) != "10.128.0.0/16" {
+		t.Errorf("Expected cidr to be printed as 10.128.0.0/16 and it was %s", Ipv4CidrBytesToString(cidr))
+	}
+
+	firstAddr, lastAddr := Ipv4CidrBoundaries(cidr)
+	if Ipv4BytesToString(firstAddr) != "10.128.0.0" || Ipv4BytesToString(lastAddr) != "10.128.255.255" {
+		t.Errorf("Expected cidr boundaries to be 10.128.0.0-10.128.255.255 and they were %s-%s", Ipv4BytesToString(firstAddr), Ipv4BytesToString(lastAddr))
+	}
+
+	boundariesCidr, boundariesCidrErr := Ipv4BoundariesToCidr(firstAddr, lastAddr)
+	if boundariesCidrErr != nil {
+		t.Errorf("Cidr test failed converting boundaries to a cidr: %s", boundariesCidrErr.Error())
+	}
+	if Ipv4CidrBytesToString(boundariesCidr) != "10.128.0.0/16" {
+		t.Errorf("Expected boundaries to convert to 10.128.0.0/16 and they converted to %s", Ipv4CidrBytesToString(boundariesCidr))
+	}
+
+	_, unalignedErr := Ipv4CidrStringToBytes("10.128.1.0/16")
+	if unalignedErr == nil {
+		t.Errorf("Expected cidr with bits set outside of its prefix to be rejected")
+	}
+
+	unalignedFirstAddr, _ := Ipv4StringToBytes("10.128.0.1")
+	_, unalignedBoundariesErr := Ipv4BoundariesToCidr(unalignedFirstAddr, lastAddr)
+	if unalignedBoundariesErr == nil {
+		t.Errorf("Expected boundaries not matching a cidr to be rejected")
+	}
+}
+
+func TestIntegerBytes(t *testing.T) {
+	vlan, vlanErr := IntegerToBytes(4095, 12)
+	if vlanErr != nil {
+		t.Errorf("Integer test failed encoding vlan id: %s", vlanErr.Error())
+	}
+	if len(vlan) != 2 || IntegerBytesToInt64(vlan) != 4095 {
+		t.Errorf("Expected vlan id to be encoded as 4095 on 2 bytes and it was %s on %d bytes", IntegerBytesToString(vlan), len(vlan))
+	}
+
+	port, portErr := IntegerToBytes(65535, 16)
+	if portErr != nil {
+		t.Errorf("Integer test failed encoding port: %s", portErr.Error())
+	}
+	if IntegerBytesToInt64(IncAddressBy1(port)) != 65536 {
+		t.Errorf("Expected the integer following the largest 16 bits integer to be 65536 and it was %s", IntegerBytesToString(IncAddressBy1(port)))
+	}
+
+	_, overflowErr := IntegerToBytes(4096, 12)
+	if overflowErr == nil {
+		t.Errorf("Expected integer larger than its bit width to be rejected")
+	}
+
+	firstAsn, _ := IntegerToBytes(4200000000, 32)
+	lastAsn, _ := IntegerToBytes(4294967294, 32)
+	asnCount := IntegerRangeAddressCount(firstAsn, lastAsn)
+	if asnCount != int64(94967295) {
+		t.Errorf("Expected range count between first and last asn to be 94967295 and it was %d", asnCount)
+	}
+}
+func TestAddressAtOffset(t *testing.T) {
+	first, _ := MacStringToBytes("52:54:00:00:ff:fe")
+	last, _ := MacStringToBytes("52:54:00:01:00:01")
+
+	size := AddressRangeSize(first, last)
+	if size.Int64() != int64(4) {
+		t.Errorf("Expected range size between first and last mac to be 4 and it was %s", size.String())
+	}
+
+	offsetAddr := AddressAtOffset(first, big.NewInt(2))
+	if MacBytesToString(offsetAddr) != "52:54:00:01:00:00" {
+		t.Errorf("Expected mac at offset 2 to be 52:54:00:01:00:00 and it was %s", MacBytesToString(offsetAddr))
+	}
+
+	lastAddr := AddressAtOffset(first, new(big.Int).Sub(size, big.NewInt(1)))
+	if !bytes.Equal(lastAddr, last) {
+		t.Errorf("Expected mac at the last offset to be the last mac and it was %s", MacBytesToString(lastAddr))
+	}
+
+	ipv4First, _ := Ipv4StringToBytes("10.0.0.255")
+	ipv4Addr := AddressAtOffset(ipv4First, big.NewInt(1))
+	if len(ipv4Addr) != len(ipv4First) || Ipv4BytesToString(ipv4Addr) != "10.0.1.0" {
+		t.Errorf("Expected ipv4 at offset 1 to be 10.0.1.0 on %d bytes and it was %s on %d bytes", len(ipv4First), Ipv4BytesToString(ipv4Addr), len(ipv4Addr))
+	}
+}
+
+func TestAddressRangeExclusions(t *testing.T) {
+	first, _ := Ipv4StringToBytes("10.0.0.1")
+	last, _ := Ipv4StringToBytes("10.0.0.254")
+	gateway, _ := Ipv4StringToBytes("10.0.0.1")
+	dhcpFirst, _ := Ipv4StringToBytes("10.0.0.100")
+	dhcpLast, _ := Ipv4StringToBytes("10.0.0.150")
+	inDhcp, _ := Ipv4StringToBytes("10.0.0.120")
+	outside, _ := Ipv4StringToBytes("10.0.0.99")
+
+	addrRange := AddressRange{
+		Type: "ipv4",
+		FirstAddress: first,
+		LastAddress: last,
+		Exclusions: []AddressExclusion{
+			AddressExclusion{gateway, gateway},
+			AddressExclusion{dhcpFirst, dhcpLast},
+		},
+	}
+
+	if validationErr := addrRange.ValidateExclusions(Ipv4BytesToString); validationErr != nil {
+		t.Errorf("Expected exclusions to be valid and got error: %s", validationErr.Error())
+	}
+
+	exclusion, isExcluded := addrRange.GetExclusion(inDhcp)
+	if !isExcluded || !bytes.Equal(exclusion.LastAddress, dhcpLast) {
+		t.Errorf("Expected address 10.0.0.120 to be in the dhcp exclusion")
+	}
+
+	if _, isExcluded := addrRange.GetExclusion(outside); isExcluded {
+		t.Errorf("Expected address 10.0.0.99 not to be excluded")
+	}
+
+	addrRange.Exclusions = append(addrRange.Exclusions, AddressExclusion{outside, inDhcp})
+	if addrRange.ValidateExclusions(Ipv4BytesToString) == nil {
+		t.Errorf("Expected overlapping exclusions to be rejected")
+	}
+
+	beyond, _ := Ipv4StringToBytes("10.0.1.1")
+	addrRange.Exclusions = []AddressExclusion{AddressExclusion{dhcpFirst, beyond}}
+	if addrRange.ValidateExclusions(Ipv4BytesToString) == nil {
+		t.Errorf("Expected exclusion outside of range boundaries to be rejected")
+	}
+}
+
+
+func TestIpv4CidrHostBoundaries(t *testing.T) {
+	cidr, cidrErr := Ipv4CidrStringToBytes("10.1.2.0/24")
+	if cidrErr != nil {
+		t.Errorf("Cidr host boundaries test failed parsing cidr: %s", cidrErr.Error())
+	}
+
+	firstAddr, lastAddr := Ipv4CidrHostBoundaries(cidr)
+	if Ipv4BytesToString(firstAddr) != "10.1.2.1" || Ipv4BytesToString(lastAddr) != "10.1.2.254" {
+		t.Errorf("Expected host boundaries of 10.1.2.0/24 to be 10.1.2.1-10.1.2.254 and they were %s-%s", Ipv4BytesToString(firstAddr), Ipv4BytesToString(lastAddr))
+	}
+
+	if Ipv4CidrNetmask(cidr) != "255.255.255.0" {
+		t.Errorf("Expected netmask of 10.1.2.0/24 to be 255.255.255.0 and it was %s", Ipv4CidrNetmask(cidr))
+	}
+
+	pointToPoint, _ := Ipv4CidrStringToBytes("10.1.2.0/31")
+	firstAddr, lastAddr = Ipv4CidrHostBoundaries(pointToPoint)
+	if Ipv4BytesToString(firstAddr) != "10.1.2.0" || Ipv4BytesToString(lastAddr) != "10.1.2.1" {
+		t.Errorf("Expected host boundaries of 10.1.2.0/31 to be 10.1.2.0-10.1.2.1 and they were %s-%s", Ipv4BytesToString(firstAddr), Ipv4BytesToString(lastAddr))
+	}
+}
+
+func TestIpv4HostBitsToIpv6(t *testing.T) {
+	ipv6, _ := Ipv6StringToBytes("fd00:10:1::1")
+	ipv4, _ := Ipv4StringToBytes("10.1.2.23")
+
+	derived := Ipv6BytesToString(Ipv4HostBitsToIpv6(ipv6, ipv4, 24))
+	if derived != "fd00:10:1::17" {
+		t.Errorf("Expected ipv6 derived from the host bits of 10.1.2.23/24 to be fd00:10:1::17 and it was %s", derived)
+	}
+
+	derived = Ipv6BytesToString(Ipv4HostBitsToIpv6(ipv6, ipv4, 0))
+	if derived != "fd00:10:1::a01:217" {
+		t.Errorf("Expected ipv6 derived from all the bits of 10.1.2.23 to be fd00:10:1::a01:217 and it was %s", derived)
+	}
+
+	derived = Ipv6BytesToString(Ipv4HostBitsToIpv6(ipv6, ipv4, 32))
+	if derived != "fd00:10:1::" {
+		t.Errorf("Expected ipv6 derived from an ipv4 without host bits to be fd00:10:1:: and it was %s", derived)
+	}
+}
+
+func TestMacToEui64Ipv6(t *testing.T) {
+	prefix, prefixErr := Ipv6Prefix64StringToBytes("2001:db8:1:2::/64")
+	if prefixErr != nil {
+		t.Errorf("Eui-64 test failed parsing prefix: %s", prefixErr.Error())
+	}
+
+	mac, _ := MacStringToBytes("52:54:00:12:34:56")
+	derived, derivedErr := MacToEui64Ipv6(prefix, mac)
+	if derivedErr != nil {
+		t.Errorf("Eui-64 test failed deriving address: %s", derivedErr.Error())
+	}
+	if Ipv6BytesToString(derived) != "2001:db8:1:2:5054:ff:fe12:3456" {
+		t.Errorf("Expected eui-64 address of 52:54:00:12:34:56 in 2001:db8:1:2::/64 to be 2001:db8:1:2:5054:ff:fe12:3456 and it was %s", Ipv6BytesToString(derived))
+	}
+
+	universal, _ := MacStringToBytes("00:1a:2b:3c:4d:5e")
+	derived, _ = MacToEui64Ipv6(prefix, universal)
+	if Ipv6BytesToString(derived) != "2001:db8:1:2:21a:2bff:fe3c:4d5e" {
+		t.Errorf("Expected eui-64 address of 00:1a:2b:3c:4d:5e in 2001:db8:1:2::/64 to be 2001:db8:1:2:21a:2bff:fe3c:4d5e and it was %s", Ipv6BytesToString(derived))
+	}
+
+	eui64, _ := MacStringToBytes("00:1a:2b:ff:fe:3c:4d:5e")
+	_, derivedErr = MacToEui64Ipv6(prefix, eui64)
+	if derivedErr == nil {
+		t.Errorf("Expected deriving an eui-64 address from a 64 bits mac address to fail and it didn't")
+	}
+
+	_, prefixErr = Ipv6Prefix64StringToBytes("2001:db8:1::/48")
+	if prefixErr == nil {
+		t.Errorf("Expected parsing an ipv6 prefix that is not a /64 to fail and it didn't")
+	}
+}
+
+func TestMacRangePolicy(t *testing.T) {
+	oui, ouiErr := MacOuiStringToBytes("52-54-00")
+	if ouiErr != nil {
+		t.Errorf("Mac policy test failed parsing oui: %s", ouiErr.Error())
+	}
+
+	if _, ouiErr = MacOuiStringToBytes("52:54"); ouiErr == nil {
+		t.Errorf("Expected parsing an oui of 2 bytes to fail and it didn't")
+	}
+
+	first, last := MacOuiBoundaries(oui)
+	if MacBytesToString(first) != "52:54:00:00:00:00" || MacBytesToString(last) != "52:54:00:ff:ff:ff" {
+		t.Errorf("Expected boundaries of oui 52:54:00 to be 52:54:00:00:00:00-52:54:00:ff:ff:ff and they were %s-%s", MacBytesToString(first), MacBytesToString(last))
+	}
+
+	ouiRange := AddressRange{
+		Type: "mac",
+		FirstAddress: first,
+		LastAddress: last,
+		Attributes: map[string]string{MacOuiAttribute: MacOuiBytesToString(oui)},
+	}
+
+	if policyErr := ouiRange.ValidateMacPolicy(MacBytesToString); policyErr != nil {
+		t.Errorf("Expected oui range to be valid and got error: %s", policyErr.Error())
+	}
+
+	otherOui, _ := MacStringToBytes("52:55:00:00:00:01")
+	if ouiRange.ValidateMacAddress(otherOui, MacBytesToString) == nil {
+		t.Errorf("Expected address 52:55:00:00:00:01 to be rejected in range of oui 52:54:00")
+	}
+
+	localFirst, _ := MacStringToBytes("02:00:00:00:00:00")
+	localLast, _ := MacStringToBytes("02:ff:ff:ff:ff:ff")
+	localRange := AddressRange{
+		Type: "mac",
+		FirstAddress: localFirst,
+		LastAddress: localLast,
+		Attributes: map[string]string{MacPolicyAttribute: MacPolicyLocallyAdministeredUnicast},
+	}
+
+	if policyErr := localRange.ValidateMacPolicy(MacBytesToString); policyErr != nil {
+		t.Errorf("Expected locally administered unicast range to be valid and got error: %s", policyErr.Error())
+	}
+
+	global, _ := MacStringToBytes("00:1a:2b:3c:4d:5e")
+	if localRange.ValidateMacAddress(global, MacBytesToString) == nil {
+		t.Errorf("Expected globally administered address 00:1a:2b:3c:4d:5e to be rejected")
+	}
+
+	multicast, _ := MacStringToBytes("03:00:00:00:00:01")
+	if localRange.ValidateMacAddress(multicast, MacBytesToString) == nil {
+		t.Errorf("Expected multicast address 03:00:00:00:00:01 to be rejected")
+	}
+
+	localRange.LastAddress, _ = MacStringToBytes("06:00:00:00:00:00")
+	if localRange.ValidateMacPolicy(MacBytesToString) == nil {
+		t.Errorf("Expected range spanning multicast and globally administered addresses to be rejected")
+	}
+}
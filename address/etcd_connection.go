@@ -1,6 +1,9 @@
 package address
 
 import (
+	"errors"
+	"fmt"
+
 	"google.golang.org/grpc/codes"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -11,6 +14,8 @@ type EtcdConnection struct {
 	Timeout int
 	Retries int
 	Strict  bool
+	RegistryPrefix string
+	MaxTxnOps int
 }
 
 func shouldRetry(err error, retries int) bool {
@@ -24,4 +29,13 @@ func shouldRetry(err error, retries int) bool {
 	}
 
 	return true
+}
+
+//Etcd rejects transactions with more comparisons or operations than its --max-txn-ops flag with an opaque error
+func (conn *EtcdConnection) checkTxnSize(conds int, ops int, action string) error {
+	if conn.MaxTxnOps <= 0 || (conds <= conn.MaxTxnOps && ops <= conn.MaxTxnOps) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("Error %s: Transaction would have %d comparisons and %d operations, above the limit of %d per transaction. Split the addresses in smaller sets or raise the --max-txn-ops flag of etcd along with the max_txn_ops argument of the provider", action, conds, ops, conn.MaxTxnOps))
 }
\ No newline at end of file
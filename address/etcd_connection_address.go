@@ -20,17 +20,23 @@ type IncrementAddress func([]byte) []byte
 
 type AddrEtcdKeyPrefixes struct {
 	DeletedAddress string
+	QuarantinedAddress string
 	HardcodedAddress string
 	GeneratedAddress string
 	Name string
+	Block string
+	Labels string
 }
 
 func GenerateAddrEtcdKeyPrefixes(rangePrefix string) AddrEtcdKeyPrefixes {
 	return AddrEtcdKeyPrefixes{
 		DeletedAddress: rangePrefix + "data/address/deleted/",
+		QuarantinedAddress: rangePrefix + "data/address/quarantined/",
 		HardcodedAddress: rangePrefix + "data/address/hardcoded/",
 		GeneratedAddress: rangePrefix + "data/address/generated/",
 		Name: rangePrefix + "data/name/",
+		Block: rangePrefix + "data/block/",
+		Labels: rangePrefix + "data/labels/",
 	}
 }
 
@@ -133,12 +139,15 @@ func (conn *EtcdConnection) GetAddressList(prefix string) ([]AddressListEntry, e
 
 /*
   check before transaction:
+    - mac address complies with the oui and policy of the range
     - address is within the range
+    - address is not within an excluded sub-range of the range
   check during transaction:
     - address doesn't exist in hardcoded/
 	- address doesn't exist in generated/
 	- name doesn't exist in names/
   transaction:
+    - Remove address from deleted/ or quarantine/ if it was there
     - Insert address in hardcoded/
 	- Insert name in names/
 */
@@ -159,10 +168,19 @@ func (conn *EtcdConnection) createHardcodedAddressWithRetries(prefix string, nam
 		return errors.New(fmt.Sprintf("Error created hardcoded address '%s': Range not found", prettify(address)))
 	}
 
+	policyErr := addrRange.ValidateMacAddress(address, prettify)
+	if policyErr != nil {
+		return errors.New(fmt.Sprintf("Error created hardcoded address '%s': %s", prettify(address), policyErr.Error()))
+	}
+
 	if !AddressWithinBoundaries(address, addrRange.FirstAddress, addrRange.LastAddress) {
 		return errors.New(fmt.Sprintf("Error created hardcoded address '%s': Ip is outside of range boundaries", prettify(address)))
 	}
 
+	if _, isExcluded := addrRange.GetExclusion(address); isExcluded {
+		return errors.New(fmt.Sprintf("Error created hardcoded address '%s': Address is within an excluded sub-range of the range", prettify(address)))
+	}
+
 	isDeleted, isDeletedErr := conn.addressIsDeleted(prefix, address)
 	if isDeletedErr != nil {
 		if !shouldRetry(isDeletedErr, retries) {
@@ -173,16 +191,31 @@ func (conn *EtcdConnection) createHardcodedAddressWithRetries(prefix string, nam
 		return conn.createHardcodedAddressWithRetries(prefix, name, address, prettify, retries - 1)
 	}
 
+	isQuarantined, isQuarantinedErr := conn.addressIsQuarantined(prefix, address)
+	if isQuarantinedErr != nil {
+		if !shouldRetry(isQuarantinedErr, retries) {
+			return isQuarantinedErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createHardcodedAddressWithRetries(prefix, name, address, prettify, retries - 1)
+	}
+
 	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
 
-	if isDeleted {
+	if isDeleted || isQuarantined {
+		freedKey := addrKeyPrefixes.DeletedAddress + string(address)
+		if isQuarantined {
+			freedKey = addrKeyPrefixes.QuarantinedAddress + string(address)
+		}
+
 		tx := conn.Client.Txn(ctx).If(
-			clientv3.Compare(clientv3.Version(addrKeyPrefixes.DeletedAddress + string(address)), ">", 0),
+			clientv3.Compare(clientv3.Version(freedKey), ">", 0),
 			clientv3.Compare(clientv3.Version(addrKeyPrefixes.HardcodedAddress + string(address)), "=", 0),
 			clientv3.Compare(clientv3.Version(addrKeyPrefixes.GeneratedAddress + string(address)), "=", 0),
 			clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), "=", 0),
 		).Then(
-			clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + string(address)),
+			clientv3.OpDelete(freedKey),
 			clientv3.OpPut(addrKeyPrefixes.HardcodedAddress + string(address), name),
 			clientv3.OpPut(addrKeyPrefixes.Name + name, string(address)),
 		)
@@ -241,18 +274,22 @@ func (conn *EtcdConnection) CreateHardcodedAddress(prefix string, name string, a
       - next address version has not changed
 	  - address exists in hardcoded/
 	  - name exists in name/
+	  - address does not exist in quarantine/ if the range has a reuse delay
     transaction:
       - delete address from hardcoded/
 	  - delete name from name/
+	  - delete labels of name from labels/
+	  - add address to quarantine/ if the range has a reuse delay (the sequential walker skips it until it is released)
   if address less than next address:\
     check during transaction:
-	  - address does not exist in deleted/
+	  - address does not exist in deleted/ (or quarantine/ if the range has a reuse delay)
 	  - address exists in hardcoded/
 	  - name exists in name/
     transaction:
       - delete address from hardcoded/
 	  - delete name from name/
-	  - add address to deleted/
+	  - delete labels of name from labels/
+	  - add address to deleted/ (or quarantine/ if the range has a reuse delay)
 */
 func (conn *EtcdConnection) deleteHardcodedAddressWithRetries(prefix string, name string, address []byte, prettify PrettifyAddr, addrIsLess AddressIsLess, retries int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
@@ -270,16 +307,36 @@ func (conn *EtcdConnection) deleteHardcodedAddressWithRetries(prefix string, nam
 		return conn.deleteHardcodedAddressWithRetries(prefix, name, address, prettify, addrIsLess, retries - 1)
 	}
 
+	freedKey, freedValue, freedErr := conn.getFreedAddressEntry(prefix, name, address)
+	if freedErr != nil {
+		if !shouldRetry(freedErr, retries) {
+			return freedErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteHardcodedAddressWithRetries(prefix, name, address, prettify, addrIsLess, retries - 1)
+	}
+
 	if !addrIsLess(address, nextAddr) {
 		addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
-		tx := conn.Client.Txn(ctx).If(
+		conds := []clientv3.Cmp{
 			clientv3.Compare(clientv3.Version(addrRangeKeys.NextAddress), "=", nextAddrVer),
 			clientv3.Compare(clientv3.Version(addrKeyPrefixes.HardcodedAddress + string(address)), ">", 0),
 			clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), ">", 0),
-		).Then(
+		}
+		ops := []clientv3.Op{
 			clientv3.OpDelete(addrKeyPrefixes.HardcodedAddress + string(address)),
 			clientv3.OpDelete(addrKeyPrefixes.Name + name),
-		)
+			clientv3.OpDelete(addrKeyPrefixes.Labels + name),
+		}
+
+		//Addresses past next address are assignable without going through deleted/, so only quarantined ones are tracked
+		if freedKey == addrKeyPrefixes.QuarantinedAddress + string(address) {
+			conds = append(conds, clientv3.Compare(clientv3.Version(freedKey), "=", 0))
+			ops = append(ops, clientv3.OpPut(freedKey, freedValue))
+		}
+
+		tx := conn.Client.Txn(ctx).If(conds...).Then(ops...)
 	
 		resp, txErr := tx.Commit()
 		if txErr != nil {
@@ -302,13 +359,14 @@ func (conn *EtcdConnection) deleteHardcodedAddressWithRetries(prefix string, nam
 	}
 
 	tx := conn.Client.Txn(ctx).If(
-		clientv3.Compare(clientv3.Version(addrKeyPrefixes.DeletedAddress + string(address)), "=", 0),
+		clientv3.Compare(clientv3.Version(freedKey), "=", 0),
 		clientv3.Compare(clientv3.Version(addrKeyPrefixes.HardcodedAddress + string(address)), ">", 0),
 		clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), ">", 0),
 	).Then(
 		clientv3.OpDelete(addrKeyPrefixes.HardcodedAddress + string(address)),
 		clientv3.OpDelete(addrKeyPrefixes.Name + name),
-		clientv3.OpPut(addrKeyPrefixes.DeletedAddress  + string(address), name),
+		clientv3.OpDelete(addrKeyPrefixes.Labels + name),
+		clientv3.OpPut(freedKey, freedValue),
 	)
 
 	resp, txErr := tx.Commit()
@@ -333,6 +391,9 @@ func (conn *EtcdConnection) DeleteHardcodedAddress(prefix string, name string, a
 }
 
 /* 
+	move addresses from quarantine/ whose reuse delay has elapsed to deleted/
+	if the range doesn't use the sequential allocation strategy:
+	  see createSpreadGeneratedAddressWithRetries
 	if deleted/ has addresses:
 	  get an address from deleted/
 	  check during transaction:
@@ -344,10 +405,10 @@ func (conn *EtcdConnection) DeleteHardcodedAddress(prefix string, name string, a
 		- Add name to name/
 	if deleted/ has no address:
 	  get next assignable address
-	  increment next address until has address not present in hardcoded/ is found
+	  increment next address (jumping over excluded sub-ranges) until an address not present in hardcoded/, quarantine/ or deleted/ is found
 	  check during transaction:
 	    - next assignable address has the same version
-		- picked address is absent from hardcoded/
+		- picked address is absent from hardcoded/, quarantine/ and deleted/
 		- name is absent from name/ for all relevant prefixes
 	  transaction:
 	    - add picked address to generated/
@@ -367,6 +428,38 @@ func (conn *EtcdConnection) createGeneratedAddressWithRetries(prefix string, mut
 		nameNoPresent = append(nameNoPresent, clientv3.Compare(clientv3.Version(addrKeyMutExclPrefixes.Name + name), "=", 0))
 	}
 
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		if !shouldRetry(addrRangeErr, retries) {
+			return []byte{}, false, addrRangeErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
+	}
+	if !addrRangeExists {
+		return []byte{}, false, errors.New("Error creating generated address: Range does not exist")
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return []byte{}, false, reuseDelayErr
+	}
+
+	releaseErr := conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, 0)
+	if releaseErr != nil {
+		if !shouldRetry(releaseErr, retries) {
+			return []byte{}, false, releaseErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
+	}
+
+	if GetAllocationStrategy(addrRange) != AllocationStrategySequential {
+		return conn.createSpreadGeneratedAddressWithRetries(prefix, addrRange, mutExclPrefixes, name, retries)
+	}
+
 	deletedAddr, deletedAddrExists, _, deletedAddrErr := conn.getDeletedAddress(prefix)
 	if deletedAddrErr != nil {
 		if !shouldRetry(deletedAddrErr, retries) {
@@ -412,19 +505,6 @@ func (conn *EtcdConnection) createGeneratedAddressWithRetries(prefix string, mut
 		return deletedAddr, false, nil
 	}
 
-	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
-	if addrRangeErr != nil {
-		if !shouldRetry(addrRangeErr, retries) {
-			return []byte{}, false, addrRangeErr
-		}
-
-		time.Sleep(100 * time.Millisecond)
-		return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
-	}
-	if !addrRangeExists {
-		return []byte{}, false, errors.New("Error creating generated address: Range does not exist")
-	}
-
 	nextAddr, nextAddrVer, nextAddrErr := conn.getNextAddress(prefix)
 	if nextAddrErr != nil {
 		if !shouldRetry(nextAddrErr, retries) {
@@ -440,33 +520,58 @@ func (conn *EtcdConnection) createGeneratedAddressWithRetries(prefix string, mut
 		return []byte{}, true, nil
 	}
 
-	isHardcoded, isHarcodedErr := conn.addressIsHardcoded(prefix, nextAddr)
-	if isHarcodedErr != nil {
-		if !shouldRetry(isHarcodedErr, retries) {
-			return []byte{}, false, isHarcodedErr
-		}
-
-		time.Sleep(100 * time.Millisecond)
-		return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
-	}
-
-	for isHardcoded {
-		nextAddr = incAddr(nextAddr)
+	for {
+		exclusion, isExcluded := addrRange.GetExclusion(nextAddr)
+		if isExcluded {
+			if !AddressLessThan(exclusion.LastAddress, addrRange.LastAddress) {
+				//Range is full
+				return []byte{}, true, nil
+			}
 
-		if addrIsGreater(nextAddr, addrRange.LastAddress) {
-			//Range is full
-			return []byte{}, true, nil
+			nextAddr = incAddr(exclusion.LastAddress)
+			continue
 		}
 
-		isHardcoded, isHarcodedErr = conn.addressIsHardcoded(prefix, nextAddr)
+		isHardcoded, isHarcodedErr := conn.addressIsHardcoded(prefix, nextAddr)
 		if isHarcodedErr != nil {
 			if !shouldRetry(isHarcodedErr, retries) {
 				return []byte{}, false, isHarcodedErr
 			}
-	
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
+		}
+
+		isQuarantined, isQuarantinedErr := conn.addressIsQuarantined(prefix, nextAddr)
+		if isQuarantinedErr != nil {
+			if !shouldRetry(isQuarantinedErr, retries) {
+				return []byte{}, false, isQuarantinedErr
+			}
+
 			time.Sleep(100 * time.Millisecond)
 			return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
 		}
+
+		isDeleted, isDeletedErr := conn.addressIsDeleted(prefix, nextAddr)
+		if isDeletedErr != nil {
+			if !shouldRetry(isDeletedErr, retries) {
+				return []byte{}, false, isDeletedErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, addrIsGreater, incAddr, retries - 1)
+		}
+
+		if !isHardcoded && !isQuarantined && !isDeleted {
+			break
+		}
+
+		nextAddr = incAddr(nextAddr)
+
+		if addrIsGreater(nextAddr, addrRange.LastAddress) {
+			//Range is full
+			return []byte{}, true, nil
+		}
 	}
 
 	tx := conn.Client.Txn(ctx).If(
@@ -474,6 +579,8 @@ func (conn *EtcdConnection) createGeneratedAddressWithRetries(prefix string, mut
 			[]clientv3.Cmp{
 				clientv3.Compare(clientv3.Version(addrRangeKeys.NextAddress), "=", nextAddrVer),
 				clientv3.Compare(clientv3.Version(addrKeyPrefixes.HardcodedAddress + string(nextAddr)), "=", 0),
+				clientv3.Compare(clientv3.Version(addrKeyPrefixes.QuarantinedAddress + string(nextAddr)), "=", 0),
+				clientv3.Compare(clientv3.Version(addrKeyPrefixes.DeletedAddress + string(nextAddr)), "=", 0),
 			},
 			nameNoPresent,
 		)...
@@ -516,6 +623,113 @@ func (conn *EtcdConnection) CreateGeneratedAddress(prefix string, name string, a
 	return addr, nil
 }
 
+/*
+	Looks for an address previously held by the name in deleted/, then in quarantine/.
+	Returns the address, its key and the mod revision of the key.
+*/
+func (conn *EtcdConnection) findFreedAddressByName(prefix string, name string) ([]byte, string, int64, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.DeletedAddress, clientv3.WithPrefix())
+	if err != nil {
+		return []byte{}, "", 0, false, err
+	}
+
+	for _, kv := range getRes.Kvs {
+		if string(kv.Value) == name {
+			return bytes.TrimPrefix(kv.Key, []byte(addrKeyPrefixes.DeletedAddress)), string(kv.Key), kv.ModRevision, true, nil
+		}
+	}
+
+	quarRes, quarErr := conn.Client.Get(ctx, addrKeyPrefixes.QuarantinedAddress, clientv3.WithPrefix())
+	if quarErr != nil {
+		return []byte{}, "", 0, false, quarErr
+	}
+
+	for _, kv := range quarRes.Kvs {
+		quarName, _, decodeErr := decodeQuarantineEntry(string(kv.Value))
+		if decodeErr != nil {
+			return []byte{}, "", 0, false, decodeErr
+		}
+
+		if quarName == name {
+			return bytes.TrimPrefix(kv.Key, []byte(addrKeyPrefixes.QuarantinedAddress)), string(kv.Key), kv.ModRevision, true, nil
+		}
+	}
+
+	return []byte{}, "", 0, false, nil
+}
+
+/*
+	get an address previously held by the name from deleted/ or quarantine/
+	check during transaction:
+	  - picked address entry was not modified since it was read
+	  - name is absent from name/ for all relevant prefixes
+	transaction:
+	  - remove picked address from deleted/ or quarantine/
+	  - add picked address to generated/
+	  - add name to name/
+	If no such address is found or the transaction check fails, false is returned and the caller should fall back to a regular generated address
+*/
+func (conn *EtcdConnection) reclaimGeneratedAddressWithRetries(prefix string, mutExclPrefixes []string, name string, retries int) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	freedAddr, freedKey, freedRev, freedAddrExists, freedAddrErr := conn.findFreedAddressByName(prefix, name)
+	if freedAddrErr != nil {
+		if !shouldRetry(freedAddrErr, retries) {
+			return []byte{}, false, freedAddrErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.reclaimGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, retries - 1)
+	}
+
+	if !freedAddrExists {
+		return []byte{}, false, nil
+	}
+
+	nameNoPresent := []clientv3.Cmp{}
+	for _, mutExclPrefix := range mutExclPrefixes{
+		addrKeyMutExclPrefixes := GenerateAddrEtcdKeyPrefixes(mutExclPrefix)
+		nameNoPresent = append(nameNoPresent, clientv3.Compare(clientv3.Version(addrKeyMutExclPrefixes.Name + name), "=", 0))
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		slices.Concat(
+			[]clientv3.Cmp{
+				clientv3.Compare(clientv3.ModRevision(freedKey), "=", freedRev),
+			},
+			nameNoPresent,
+		)...
+	).Then(
+		clientv3.OpDelete(freedKey),
+		clientv3.OpPut(addrKeyPrefixes.GeneratedAddress + string(freedAddr), name),
+		clientv3.OpPut(addrKeyPrefixes.Name + name, string(freedAddr)),
+	)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return []byte{}, false, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.reclaimGeneratedAddressWithRetries(prefix, mutExclPrefixes, name, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		return []byte{}, false, nil
+	}
+
+	return freedAddr, true, nil
+}
+
 /* 
 	check during transaction:
 	  - address is present in generated/
@@ -523,7 +737,8 @@ func (conn *EtcdConnection) CreateGeneratedAddress(prefix string, name string, a
 	transaction:
 	  - remote address from generated/
 	  - remove name from name/ 
-	  - add address to deleted/
+	  - remove labels of name from labels/
+	  - add address to deleted/ (or to quarantine/ if the range has a reuse delay)
 */
 func (conn *EtcdConnection) deleteGeneratedAddressWithRetries(prefix string, name string, address []byte, prettify PrettifyAddr, retries int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
@@ -531,13 +746,24 @@ func (conn *EtcdConnection) deleteGeneratedAddressWithRetries(prefix string, nam
 
 	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
 
+	freedKey, freedValue, freedErr := conn.getFreedAddressEntry(prefix, name, address)
+	if freedErr != nil {
+		if !shouldRetry(freedErr, retries) {
+			return freedErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteGeneratedAddressWithRetries(prefix, name, address, prettify, retries - 1)
+	}
+
 	tx := conn.Client.Txn(ctx).If(
 		clientv3.Compare(clientv3.Version(addrKeyPrefixes.GeneratedAddress + string(address)), ">", 0),
 		clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), ">", 0),
 	).Then(
 		clientv3.OpDelete(addrKeyPrefixes.GeneratedAddress + string(address)),
 		clientv3.OpDelete(addrKeyPrefixes.Name + name),
-		clientv3.OpPut(addrKeyPrefixes.DeletedAddress  + string(address), name),
+		clientv3.OpDelete(addrKeyPrefixes.Labels + name),
+		clientv3.OpPut(freedKey, freedValue),
 	)
 
 	resp, txErr := tx.Commit()
@@ -635,6 +861,43 @@ func (conn *EtcdConnection) GetAddressDetails(prefix string, name string) (bool,
 	return conn.getAddressDetailsWithRetries(prefix, name, conn.Retries)
 }
 
+//Returns the name the address is assigned to in the range and whether the address is hardcoded
+func (conn *EtcdConnection) getAddressOwnerWithRetries(prefix string, address []byte, retries int) (bool, bool, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	resp, err := conn.Client.Txn(ctx).Then(
+		clientv3.OpGet(addrKeyPrefixes.GeneratedAddress + string(address)),
+		clientv3.OpGet(addrKeyPrefixes.HardcodedAddress + string(address)),
+	).Commit()
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return false, false, "", err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getAddressOwnerWithRetries(prefix, address, retries - 1)
+	}
+
+	generatedKvs := resp.Responses[0].GetResponseRange().Kvs
+	if len(generatedKvs) > 0 {
+		return true, false, string(generatedKvs[0].Value), nil
+	}
+
+	hardcodedKvs := resp.Responses[1].GetResponseRange().Kvs
+	if len(hardcodedKvs) > 0 {
+		return true, true, string(hardcodedKvs[0].Value), nil
+	}
+
+	return false, false, "", nil
+}
+
+func (conn *EtcdConnection) GetAddressOwner(prefix string, address []byte) (bool, bool, string, error) {
+	return conn.getAddressOwnerWithRetries(prefix, address, conn.Retries)
+}
+
 //Multi-range methods
 func (conn *EtcdConnection) FindAddressRangeByBoundaries(prefixes []string, addr []byte) (string, AddressRange, bool, error) {
 	for _, prefix := range prefixes {
@@ -671,4 +934,19 @@ func (conn *EtcdConnection) FindAddressDetailsInRanges(prefixes []string, name s
 	}
 
 	return false, false, []byte{}, "", nil
+}
+
+func (conn *EtcdConnection) FindAddressOwnerInRanges(prefixes []string, address []byte) (bool, bool, string, string, error) {
+	for _, prefix := range prefixes {
+		addrExists, addrIsHardcoded, name, ownerErr := conn.GetAddressOwner(prefix, address)
+		if ownerErr != nil {
+			return false, false, "", "", ownerErr
+		}
+
+		if addrExists {
+			return addrExists, addrIsHardcoded, name, prefix, nil
+		}
+	}
+
+	return false, false, "", "", nil
 }
\ No newline at end of file
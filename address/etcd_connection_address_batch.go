@@ -0,0 +1,551 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type batchFreedAddress struct {
+	Address []byte
+	Key string
+}
+
+//In-memory view of the data of a range, used to pick the addresses of a batch without a round-trip per address
+type batchAllocationSnapshot struct {
+	//Addresses of the names in name/
+	Names map[string][]byte
+	Hardcoded map[string]bool
+	Quarantined map[string]bool
+	//Addresses in generated/, hardcoded/ or quarantine/, plus those picked for the batch so far
+	Unavailable map[string]bool
+	//Addresses in deleted/, in ascending order
+	Deleted [][]byte
+	IsDeleted map[string]bool
+	FreedByName map[string]batchFreedAddress
+	NextAddress []byte
+	Revision int64
+}
+
+func (conn *EtcdConnection) getBatchAllocationSnapshot(prefix string) (batchAllocationSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	getRes, err := conn.Client.Get(ctx, prefix + "data/", clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return batchAllocationSnapshot{}, err
+	}
+
+	snapshot := batchAllocationSnapshot{
+		Names: map[string][]byte{},
+		Hardcoded: map[string]bool{},
+		Quarantined: map[string]bool{},
+		Unavailable: map[string]bool{},
+		Deleted: [][]byte{},
+		IsDeleted: map[string]bool{},
+		FreedByName: map[string]batchFreedAddress{},
+		NextAddress: []byte{},
+		Revision: getRes.Header.Revision,
+	}
+
+	for _, kv := range getRes.Kvs {
+		key := string(kv.Key)
+		switch {
+		case key == addrRangeKeys.NextAddress:
+			snapshot.NextAddress = kv.Value
+		case strings.HasPrefix(key, addrKeyPrefixes.Name):
+			snapshot.Names[strings.TrimPrefix(key, addrKeyPrefixes.Name)] = kv.Value
+		case strings.HasPrefix(key, addrKeyPrefixes.GeneratedAddress):
+			snapshot.Unavailable[strings.TrimPrefix(key, addrKeyPrefixes.GeneratedAddress)] = true
+		case strings.HasPrefix(key, addrKeyPrefixes.HardcodedAddress):
+			snapshot.Unavailable[strings.TrimPrefix(key, addrKeyPrefixes.HardcodedAddress)] = true
+			snapshot.Hardcoded[strings.TrimPrefix(key, addrKeyPrefixes.HardcodedAddress)] = true
+		case strings.HasPrefix(key, addrKeyPrefixes.DeletedAddress):
+			address, _ := bytes.CutPrefix(kv.Key, []byte(addrKeyPrefixes.DeletedAddress))
+			snapshot.Deleted = append(snapshot.Deleted, address)
+			snapshot.IsDeleted[string(address)] = true
+			snapshot.FreedByName[string(kv.Value)] = batchFreedAddress{address, key}
+		case strings.HasPrefix(key, addrKeyPrefixes.QuarantinedAddress):
+			address, _ := bytes.CutPrefix(kv.Key, []byte(addrKeyPrefixes.QuarantinedAddress))
+			snapshot.Unavailable[string(address)] = true
+			snapshot.Quarantined[string(address)] = true
+			name, _, decodeErr := decodeQuarantineEntry(string(kv.Value))
+			if decodeErr != nil {
+				return batchAllocationSnapshot{}, decodeErr
+			}
+			snapshot.FreedByName[name] = batchFreedAddress{address, key}
+		}
+	}
+
+	if len(snapshot.NextAddress) == 0 {
+		return batchAllocationSnapshot{}, errors.New(fmt.Sprintf("Error accessing next address for range with prefix '%s': Key not found", prefix))
+	}
+
+	return snapshot, nil
+}
+
+//Returns the address the name previously held in deleted/ or quarantine/, which becomes unavailable for the rest of the batch
+func (snapshot *batchAllocationSnapshot) reclaimFreedAddress(name string) (batchFreedAddress, bool) {
+	freedAddr, freedAddrExists := snapshot.FreedByName[name]
+	if !freedAddrExists {
+		return batchFreedAddress{}, false
+	}
+
+	snapshot.Unavailable[string(freedAddr.Address)] = true
+	return freedAddr, true
+}
+
+//Picks the smallest address of deleted/ that wasn't picked yet for the batch, otherwise the next address
+func (snapshot *batchAllocationSnapshot) pickSequentialAddress(addrRange AddressRange, addrIsGreater AddressIsGreater, incAddr IncrementAddress) ([]byte, bool) {
+	for _, deletedAddr := range snapshot.Deleted {
+		if !snapshot.Unavailable[string(deletedAddr)] {
+			return deletedAddr, false
+		}
+	}
+
+	for {
+		if addrIsGreater(snapshot.NextAddress, addrRange.LastAddress) {
+			//Range is full
+			return []byte{}, true
+		}
+
+		exclusion, isExcluded := addrRange.GetExclusion(snapshot.NextAddress)
+		if isExcluded {
+			if !AddressLessThan(exclusion.LastAddress, addrRange.LastAddress) {
+				//Range is full
+				return []byte{}, true
+			}
+
+			snapshot.NextAddress = incAddr(exclusion.LastAddress)
+			continue
+		}
+
+		pickedAddr := snapshot.NextAddress
+		snapshot.NextAddress = incAddr(pickedAddr)
+		if !snapshot.Unavailable[string(pickedAddr)] {
+			return pickedAddr, false
+		}
+	}
+}
+
+/*
+	pick a start offset in the range (random or from the hash of the name)
+	probe addresses from that offset, wrapping around the range, until one that is not excluded or unavailable is found
+*/
+func (snapshot *batchAllocationSnapshot) pickSpreadAddress(addrRange AddressRange, name string) ([]byte, bool, error) {
+	size := AddressRangeSize(addrRange.FirstAddress, addrRange.LastAddress)
+
+	unavailableCount := big.NewInt(int64(len(snapshot.Unavailable)))
+	for _, exclusion := range addrRange.Exclusions {
+		unavailableCount.Add(unavailableCount, AddressRangeSize(exclusion.FirstAddress, exclusion.LastAddress))
+	}
+
+	if unavailableCount.Cmp(size) >= 0 {
+		//Range is full
+		return []byte{}, true, nil
+	}
+
+	startOffset, startOffsetErr := getAllocationStartOffset(GetAllocationStrategy(addrRange), name, size)
+	if startOffsetErr != nil {
+		return []byte{}, false, startOffsetErr
+	}
+
+	offset := new(big.Int)
+	for probe := new(big.Int); probe.Cmp(size) < 0; probe.Add(probe, big.NewInt(1)) {
+		offset.Add(startOffset, probe)
+		offset.Mod(offset, size)
+		candidate := AddressAtOffset(addrRange.FirstAddress, offset)
+
+		if exclusion, isExcluded := addrRange.GetExclusion(candidate); isExcluded {
+			probe.Add(probe, new(big.Int).Sub(new(big.Int).SetBytes(exclusion.LastAddress), new(big.Int).SetBytes(candidate)))
+			continue
+		}
+
+		if !snapshot.Unavailable[string(candidate)] {
+			return candidate, false, nil
+		}
+	}
+
+	//Range is full
+	return []byte{}, true, nil
+}
+
+/*
+	Returns the addresses allocated to the names along with the conditions and operations of the transaction allocating them.
+	Names that already have a generated address keep it if present addresses are tolerated (and are returned as existing).
+
+	release quarantined addresses whose reuse delay has elapsed
+	read all of data/ in a single request
+	for each name, give back the address it previously held in deleted/ or quarantine/ if any
+	for the other names, pick addresses the same way individual generated addresses are picked, skipping addresses already picked for the batch
+	check during transaction:
+	  - nothing in data/ was modified since it was read
+	transaction:
+	  - remove picked addresses from deleted/ or quarantine/ if present
+	  - add picked addresses to generated/
+	  - add names to name/
+	  - set next assignable address past the last picked address (sequential ranges)
+*/
+func (conn *EtcdConnection) getGeneratedAddressBatchTransaction(prefix string, names []string, rangeType string, toleratePresent bool, addrIsGreater AddressIsGreater, incAddr IncrementAddress) (map[string][]byte, map[string]bool, []clientv3.Cmp, []clientv3.Op, error) {
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, addrRangeErr
+	}
+	if !addrRangeExists {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating addresses in range with prefix '%s': Range does not exist", prefix))
+	}
+	if addrRange.Type != rangeType {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating addresses in range with prefix '%s': Range type doesn't match the created address type", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, reuseDelayErr
+	}
+
+	releaseErr := conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, 0)
+	if releaseErr != nil {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, releaseErr
+	}
+
+	snapshot, snapshotErr := conn.getBatchAllocationSnapshot(prefix)
+	if snapshotErr != nil {
+		return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, snapshotErr
+	}
+
+	initialNextAddr := snapshot.NextAddress
+	addresses := map[string][]byte{}
+	existing := map[string]bool{}
+	ops := []clientv3.Op{}
+
+	for _, name := range names {
+		if existingAddr, exists := snapshot.Names[name]; exists {
+			if !toleratePresent {
+				return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating address '%s': Address was already present in range with prefix '%s'", name, prefix))
+			}
+
+			if snapshot.Hardcoded[string(existingAddr)] {
+				return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating address in range with prefix '%s': An existing address with the same name didn't match the expected hardcoded setting", prefix))
+			}
+
+			addresses[name] = existingAddr
+			existing[name] = true
+			continue
+		}
+
+		freedAddr, freedAddrExists := snapshot.reclaimFreedAddress(name)
+		if !freedAddrExists {
+			continue
+		}
+
+		addresses[name] = freedAddr.Address
+		ops = append(ops, clientv3.OpDelete(freedAddr.Key))
+	}
+
+	for _, name := range names {
+		if _, picked := addresses[name]; picked {
+			continue
+		}
+
+		var pickedAddr []byte
+		var full bool
+		if GetAllocationStrategy(addrRange) == AllocationStrategySequential {
+			pickedAddr, full = snapshot.pickSequentialAddress(addrRange, addrIsGreater, incAddr)
+		} else {
+			var pickErr error
+			pickedAddr, full, pickErr = snapshot.pickSpreadAddress(addrRange, name)
+			if pickErr != nil {
+				return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, pickErr
+			}
+		}
+
+		if full {
+			return map[string][]byte{}, map[string]bool{}, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating addresses in range with prefix '%s': Address range doesn't have enough free addresses for all the names", prefix))
+		}
+
+		snapshot.Unavailable[string(pickedAddr)] = true
+		addresses[name] = pickedAddr
+		if snapshot.IsDeleted[string(pickedAddr)] {
+			ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + string(pickedAddr)))
+		}
+	}
+
+	for _, name := range names {
+		if existing[name] {
+			continue
+		}
+
+		ops = append(
+			ops,
+			clientv3.OpPut(addrKeyPrefixes.GeneratedAddress + string(addresses[name]), name),
+			clientv3.OpPut(addrKeyPrefixes.Name + name, string(addresses[name])),
+		)
+	}
+
+	if !bytes.Equal(initialNextAddr, snapshot.NextAddress) {
+		ops = append(ops, clientv3.OpPut(addrRangeKeys.NextAddress, string(snapshot.NextAddress)))
+	}
+
+	return addresses, existing, []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", snapshot.Revision + 1).WithPrefix(),
+	}, ops, nil
+}
+
+/*
+	Allocates generated addresses to all the names in a single transaction (see getGeneratedAddressBatchTransaction), as the
+	individual creation of many addresses results in many round-trips and contention on the next address.
+	Etcd limits the number of operations in a transaction (128 by default), so large batches require a higher limit and
+	fail before reaching etcd if they exceed the max_txn_ops argument of the provider
+*/
+func (conn *EtcdConnection) createGeneratedAddressBatchWithRetries(prefix string, names []string, rangeType string, addrIsGreater AddressIsGreater, incAddr IncrementAddress, retries int) (map[string][]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addresses, _, conds, ops, txBuildErr := conn.getGeneratedAddressBatchTransaction(prefix, names, rangeType, false, addrIsGreater, incAddr)
+	if txBuildErr != nil {
+		if !shouldRetry(txBuildErr, retries) {
+			return map[string][]byte{}, txBuildErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedAddressBatchWithRetries(prefix, names, rangeType, addrIsGreater, incAddr, retries - 1)
+	}
+
+	sizeErr := conn.checkTxnSize(len(conds), len(ops), fmt.Sprintf("creating %d addresses in range with prefix '%s'", len(names), prefix))
+	if sizeErr != nil {
+		return map[string][]byte{}, sizeErr
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return map[string][]byte{}, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedAddressBatchWithRetries(prefix, names, rangeType, addrIsGreater, incAddr, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return map[string][]byte{}, errors.New("Failed to create generated addresses: Range was modified concurrently")
+		}
+
+		return conn.createGeneratedAddressBatchWithRetries(prefix, names, rangeType, addrIsGreater, incAddr, retries - 1)
+	}
+
+	return addresses, nil
+}
+
+func (conn *EtcdConnection) CreateGeneratedAddressBatch(prefix string, names []string, rangeType string, addrIsGreater AddressIsGreater, incAddr IncrementAddress) (map[string][]byte, error) {
+	if len(names) == 0 {
+		return map[string][]byte{}, nil
+	}
+
+	return conn.createGeneratedAddressBatchWithRetries(prefix, names, rangeType, addrIsGreater, incAddr, conn.Retries)
+}
+
+/*
+	Returns the conditions and operations of the transaction freeing the generated addresses of the names.
+	Names that are not assigned are skipped if missing addresses are tolerated.
+
+	check during transaction:
+	  - names are present in name/ with the expected addresses
+	  - addresses are present in generated/
+	transaction:
+	  - remove addresses from generated/
+	  - remove names from name/
+	  - remove labels of names from labels/
+	  - add addresses to deleted/ (or to quarantine/ if the range has a reuse delay)
+*/
+func (conn *EtcdConnection) getGeneratedAddressBatchRemovalTransaction(prefix string, addresses map[string][]byte, tolerateMissing bool) ([]clientv3.Cmp, []clientv3.Op, error) {
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, addrRangeErr
+	}
+	if !addrRangeExists {
+		return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting addresses in range with prefix '%s': Range does not exist", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, reuseDelayErr
+	}
+
+	listing, listingErr := conn.getAddressListWithRetries(prefix, 0)
+	if listingErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, listingErr
+	}
+
+	assigned := map[string][]byte{}
+	for _, entry := range listing {
+		assigned[entry.Name] = entry.Address
+	}
+
+	conds := []clientv3.Cmp{}
+	ops := []clientv3.Op{}
+	for name, address := range addresses {
+		assignedAddr, isAssigned := assigned[name]
+		if !isAssigned {
+			if !tolerateMissing {
+				return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting address '%s' in range at prefix '%s': Address was not found in range", name, prefix))
+			}
+
+			continue
+		}
+
+		if !bytes.Equal(assignedAddr, address) {
+			return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting address '%s' in range at prefix '%s': Address didn't have expected value", name, prefix))
+		}
+
+		freedKey, freedValue := freedAddressEntry(prefix, name, address, reuseDelay)
+		conds = append(
+			conds,
+			clientv3.Compare(clientv3.Value(addrKeyPrefixes.Name + name), "=", string(address)),
+			clientv3.Compare(clientv3.Version(addrKeyPrefixes.GeneratedAddress + string(address)), ">", 0),
+		)
+		ops = append(
+			ops,
+			clientv3.OpDelete(addrKeyPrefixes.GeneratedAddress + string(address)),
+			clientv3.OpDelete(addrKeyPrefixes.Name + name),
+			clientv3.OpDelete(addrKeyPrefixes.Labels + name),
+			clientv3.OpPut(freedKey, freedValue),
+		)
+	}
+
+	return conds, ops, nil
+}
+
+//Frees the generated addresses of all the names in a single transaction (see getGeneratedAddressBatchRemovalTransaction)
+func (conn *EtcdConnection) deleteGeneratedAddressBatchWithRetries(prefix string, addresses map[string][]byte, tolerateMissing bool, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	conds, ops, txBuildErr := conn.getGeneratedAddressBatchRemovalTransaction(prefix, addresses, tolerateMissing)
+	if txBuildErr != nil {
+		if !shouldRetry(txBuildErr, retries) {
+			return txBuildErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteGeneratedAddressBatchWithRetries(prefix, addresses, tolerateMissing, retries - 1)
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	sizeErr := conn.checkTxnSize(len(conds), len(ops), fmt.Sprintf("deleting %d addresses in range with prefix '%s'", len(addresses), prefix))
+	if sizeErr != nil {
+		return sizeErr
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteGeneratedAddressBatchWithRetries(prefix, addresses, tolerateMissing, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New(fmt.Sprintf("Failed to delete generated addresses in range at prefix '%s': Addresses were modified concurrently", prefix))
+		}
+
+		return conn.deleteGeneratedAddressBatchWithRetries(prefix, addresses, tolerateMissing, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) DeleteGeneratedAddressBatch(prefix string, addresses map[string][]byte, tolerateMissing bool) error {
+	return conn.deleteGeneratedAddressBatchWithRetries(prefix, addresses, tolerateMissing, conn.Retries)
+}
+
+/*
+	Frees the generated addresses of some names and allocates generated addresses to other names in a single transaction,
+	so that either the whole update is applied or none of it is. The conditions and operations are those of
+	getGeneratedAddressBatchRemovalTransaction and getGeneratedAddressBatchTransaction. The addresses being freed are still
+	unavailable when the addresses of the new names are picked, so they are not reassigned by the same update.
+*/
+func (conn *EtcdConnection) updateGeneratedAddressBatchWithRetries(prefix string, removedAddresses map[string][]byte, addedNames []string, rangeType string, tolerateMissing bool, addrIsGreater AddressIsGreater, incAddr IncrementAddress, retries int) (map[string][]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	conds, ops, removalBuildErr := conn.getGeneratedAddressBatchRemovalTransaction(prefix, removedAddresses, tolerateMissing)
+	if removalBuildErr != nil {
+		if !shouldRetry(removalBuildErr, retries) {
+			return map[string][]byte{}, removalBuildErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.updateGeneratedAddressBatchWithRetries(prefix, removedAddresses, addedNames, rangeType, tolerateMissing, addrIsGreater, incAddr, retries - 1)
+	}
+
+	addresses := map[string][]byte{}
+	if len(addedNames) > 0 {
+		addedAddresses, _, creationConds, creationOps, creationBuildErr := conn.getGeneratedAddressBatchTransaction(prefix, addedNames, rangeType, false, addrIsGreater, incAddr)
+		if creationBuildErr != nil {
+			if !shouldRetry(creationBuildErr, retries) {
+				return map[string][]byte{}, creationBuildErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.updateGeneratedAddressBatchWithRetries(prefix, removedAddresses, addedNames, rangeType, tolerateMissing, addrIsGreater, incAddr, retries - 1)
+		}
+
+		addresses = addedAddresses
+		conds = append(conds, creationConds...)
+		ops = append(ops, creationOps...)
+	}
+
+	if len(ops) == 0 {
+		return addresses, nil
+	}
+
+	sizeErr := conn.checkTxnSize(len(conds), len(ops), fmt.Sprintf("deleting %d addresses and creating %d addresses in range with prefix '%s'", len(removedAddresses), len(addedNames), prefix))
+	if sizeErr != nil {
+		return map[string][]byte{}, sizeErr
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return map[string][]byte{}, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.updateGeneratedAddressBatchWithRetries(prefix, removedAddresses, addedNames, rangeType, tolerateMissing, addrIsGreater, incAddr, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return map[string][]byte{}, errors.New(fmt.Sprintf("Failed to update generated addresses in range at prefix '%s': Range was modified concurrently", prefix))
+		}
+
+		return conn.updateGeneratedAddressBatchWithRetries(prefix, removedAddresses, addedNames, rangeType, tolerateMissing, addrIsGreater, incAddr, retries - 1)
+	}
+
+	return addresses, nil
+}
+
+func (conn *EtcdConnection) UpdateGeneratedAddressBatch(prefix string, removedAddresses map[string][]byte, addedNames []string, rangeType string, tolerateMissing bool, addrIsGreater AddressIsGreater, incAddr IncrementAddress) (map[string][]byte, error) {
+	return conn.updateGeneratedAddressBatchWithRetries(prefix, removedAddresses, addedNames, rangeType, tolerateMissing, addrIsGreater, incAddr, conn.Retries)
+}
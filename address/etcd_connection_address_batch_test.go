@@ -0,0 +1,182 @@
+package address
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func getTestIpv4(t *testing.T, addr string) []byte {
+	addrBytes, err := Ipv4StringToBytes(addr)
+	if err != nil {
+		t.Fatalf("Test failed getting address %s: %s", addr, err.Error())
+	}
+
+	return addrBytes
+}
+
+func getTestIpv4Range(t *testing.T, firstAddr string, lastAddr string, strategy string, exclusions [][2]string) AddressRange {
+	addrRange := AddressRange{
+		Type: "ipv4",
+		FirstAddress: getTestIpv4(t, firstAddr),
+		LastAddress: getTestIpv4(t, lastAddr),
+		Attributes: map[string]string{AllocationStrategyAttribute: strategy},
+		Exclusions: []AddressExclusion{},
+	}
+
+	for _, exclusion := range exclusions {
+		addrRange.Exclusions = append(addrRange.Exclusions, AddressExclusion{
+			FirstAddress: getTestIpv4(t, exclusion[0]),
+			LastAddress: getTestIpv4(t, exclusion[1]),
+		})
+	}
+
+	return addrRange
+}
+
+//Deleted addresses must be given in ascending order, like they are read from etcd
+func getTestSnapshot(t *testing.T, nextAddr string, unavailable []string, deleted []string) batchAllocationSnapshot {
+	snapshot := batchAllocationSnapshot{
+		Names: map[string][]byte{},
+		Hardcoded: map[string]bool{},
+		Quarantined: map[string]bool{},
+		Unavailable: map[string]bool{},
+		Deleted: [][]byte{},
+		IsDeleted: map[string]bool{},
+		FreedByName: map[string]batchFreedAddress{},
+		NextAddress: getTestIpv4(t, nextAddr),
+	}
+
+	for _, addr := range unavailable {
+		snapshot.Unavailable[string(getTestIpv4(t, addr))] = true
+	}
+
+	for _, addr := range deleted {
+		snapshot.Deleted = append(snapshot.Deleted, getTestIpv4(t, addr))
+		snapshot.IsDeleted[string(getTestIpv4(t, addr))] = true
+	}
+
+	return snapshot
+}
+
+func TestPickSequentialAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		nextAddr string
+		unavailable []string
+		deleted []string
+		exclusions [][2]string
+		expectedAddr string
+		expectedNextAddr string
+		expectedFull bool
+	}{
+		{"head of deleted addresses", "10.0.0.5", []string{}, []string{"10.0.0.2", "10.0.0.3"}, [][2]string{}, "10.0.0.2", "10.0.0.5", false},
+		{"deleted address already picked", "10.0.0.5", []string{"10.0.0.2"}, []string{"10.0.0.2", "10.0.0.3"}, [][2]string{}, "10.0.0.3", "10.0.0.5", false},
+		{"next address", "10.0.0.5", []string{}, []string{}, [][2]string{}, "10.0.0.5", "10.0.0.6", false},
+		{"next address excluded", "10.0.0.5", []string{}, []string{}, [][2]string{{"10.0.0.5", "10.0.0.6"}}, "10.0.0.7", "10.0.0.8", false},
+		{"next address unavailable", "10.0.0.5", []string{"10.0.0.5"}, []string{}, [][2]string{}, "10.0.0.6", "10.0.0.7", false},
+		{"next address past the range", "10.0.0.11", []string{}, []string{}, [][2]string{}, "", "", true},
+		{"exclusion up to the end of the range", "10.0.0.9", []string{}, []string{}, [][2]string{{"10.0.0.9", "10.0.0.10"}}, "", "", true},
+		{"unavailable addresses up to the end of the range", "10.0.0.9", []string{"10.0.0.9", "10.0.0.10"}, []string{}, [][2]string{}, "", "", true},
+	}
+
+	for _, test := range tests {
+		addrRange := getTestIpv4Range(t, "10.0.0.1", "10.0.0.10", AllocationStrategySequential, test.exclusions)
+		snapshot := getTestSnapshot(t, test.nextAddr, test.unavailable, test.deleted)
+
+		addr, full := snapshot.pickSequentialAddress(addrRange, AddressGreaterThan, IncAddressBy1)
+		if full != test.expectedFull {
+			t.Errorf("Expected range to be full to be %t for '%s' and it was %t", test.expectedFull, test.name, full)
+			continue
+		}
+
+		if full {
+			continue
+		}
+
+		if Ipv4BytesToString(addr) != test.expectedAddr {
+			t.Errorf("Expected picked address for '%s' to be %s and it was %s", test.name, test.expectedAddr, Ipv4BytesToString(addr))
+		}
+
+		if Ipv4BytesToString(snapshot.NextAddress) != test.expectedNextAddr {
+			t.Errorf("Expected next address for '%s' to be %s and it was %s", test.name, test.expectedNextAddr, Ipv4BytesToString(snapshot.NextAddress))
+		}
+	}
+}
+
+func TestPickSpreadAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		strategy string
+		unavailable []string
+		exclusions [][2]string
+		expectedAddr string
+		expectedFull bool
+	}{
+		{"single free address", AllocationStrategyRandom, []string{"10.0.0.1", "10.0.0.2", "10.0.0.4"}, [][2]string{}, "10.0.0.3", false},
+		{"single address outside of exclusions", AllocationStrategyRandom, []string{"10.0.0.3"}, [][2]string{{"10.0.0.1", "10.0.0.2"}}, "10.0.0.4", false},
+		{"single free address by hash of name", AllocationStrategyHashOfName, []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"}, [][2]string{}, "10.0.0.1", false},
+		{"unavailable addresses", AllocationStrategyRandom, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}, [][2]string{}, "", true},
+		{"unavailable and excluded addresses", AllocationStrategyHashOfName, []string{"10.0.0.1", "10.0.0.4"}, [][2]string{{"10.0.0.2", "10.0.0.3"}}, "", true},
+	}
+
+	for _, test := range tests {
+		addrRange := getTestIpv4Range(t, "10.0.0.1", "10.0.0.4", test.strategy, test.exclusions)
+		snapshot := getTestSnapshot(t, "10.0.0.1", test.unavailable, []string{})
+
+		addr, full, err := snapshot.pickSpreadAddress(addrRange, "vm-1")
+		if err != nil {
+			t.Errorf("Picking spread address for '%s' failed: %s", test.name, err.Error())
+			continue
+		}
+
+		if full != test.expectedFull {
+			t.Errorf("Expected range to be full to be %t for '%s' and it was %t", test.expectedFull, test.name, full)
+			continue
+		}
+
+		if !full && Ipv4BytesToString(addr) != test.expectedAddr {
+			t.Errorf("Expected picked address for '%s' to be %s and it was %s", test.name, test.expectedAddr, Ipv4BytesToString(addr))
+		}
+	}
+
+	addrRange := getTestIpv4Range(t, "10.0.0.0", "10.0.0.255", AllocationStrategyHashOfName, [][2]string{})
+	snapshot := getTestSnapshot(t, "10.0.0.0", []string{}, []string{})
+
+	hash := sha256.Sum256([]byte("vm-1"))
+	expectedAddr := AddressAtOffset(addrRange.FirstAddress, new(big.Int).Mod(new(big.Int).SetBytes(hash[:]), big.NewInt(256)))
+	for idx := 0; idx < 2; idx++ {
+		addr, _, err := snapshot.pickSpreadAddress(addrRange, "vm-1")
+		if err != nil {
+			t.Errorf("Picking address by hash of name failed: %s", err.Error())
+		}
+
+		if Ipv4BytesToString(addr) != Ipv4BytesToString(expectedAddr) {
+			t.Errorf("Expected address picked by hash of name to be %s and it was %s", Ipv4BytesToString(expectedAddr), Ipv4BytesToString(addr))
+		}
+	}
+}
+
+func TestReclaimFreedAddress(t *testing.T) {
+	snapshot := getTestSnapshot(t, "10.0.0.5", []string{}, []string{"10.0.0.2"})
+	snapshot.FreedByName["vm-1"] = batchFreedAddress{getTestIpv4(t, "10.0.0.2"), "data/address/deleted/10.0.0.2"}
+
+	freedAddr, found := snapshot.reclaimFreedAddress("vm-1")
+	if !found || Ipv4BytesToString(freedAddr.Address) != "10.0.0.2" {
+		t.Errorf("Expected name to get back its freed address 10.0.0.2")
+	}
+
+	if !snapshot.Unavailable[string(getTestIpv4(t, "10.0.0.2"))] {
+		t.Errorf("Expected reclaimed address to be unavailable for the rest of the batch")
+	}
+
+	addr, _ := snapshot.pickSequentialAddress(getTestIpv4Range(t, "10.0.0.1", "10.0.0.10", AllocationStrategySequential, [][2]string{}), AddressGreaterThan, IncAddressBy1)
+	if Ipv4BytesToString(addr) != "10.0.0.5" {
+		t.Errorf("Expected reclaimed address to be skipped by the next pick, which should be 10.0.0.5, and it was %s", Ipv4BytesToString(addr))
+	}
+
+	_, found = snapshot.reclaimFreedAddress("vm-2")
+	if found {
+		t.Errorf("Expected name without a freed address not to get one back")
+	}
+}
@@ -0,0 +1,321 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func alignAddressValue(value *big.Int, alignment *big.Int) *big.Int {
+	remainder := new(big.Int).Mod(value, alignment)
+	if remainder.Sign() == 0 {
+		return value
+	}
+
+	return value.Add(value, new(big.Int).Sub(alignment, remainder))
+}
+
+/*
+	Returns the first and last address of the lowest run of consecutive free addresses of the given size whose first
+	address is a multiple of the alignment.
+	Free addresses are those outside of excluded sub-ranges and absent from generated/, hardcoded/ and quarantine/ (an
+	address in deleted/ is free).
+*/
+func (snapshot *batchAllocationSnapshot) findFreeBlock(addrRange AddressRange, size int64, alignment int64) ([]byte, []byte, bool) {
+	addrLen := len(addrRange.FirstAddress)
+	lastValue := new(big.Int).SetBytes(addrRange.LastAddress)
+	alignmentValue := big.NewInt(alignment)
+
+	start := alignAddressValue(new(big.Int).SetBytes(addrRange.FirstAddress), alignmentValue)
+	for {
+		end := new(big.Int).Add(start, big.NewInt(size - 1))
+		if end.Cmp(lastValue) > 0 {
+			//Range doesn't have a large enough run of free addresses
+			return []byte{}, []byte{}, true
+		}
+
+		var nextStart *big.Int
+		for value := new(big.Int).Set(start); value.Cmp(end) <= 0; value.Add(value, big.NewInt(1)) {
+			addr := value.FillBytes(make([]byte, addrLen))
+			if exclusion, isExcluded := addrRange.GetExclusion(addr); isExcluded {
+				nextStart = new(big.Int).Add(new(big.Int).SetBytes(exclusion.LastAddress), big.NewInt(1))
+				break
+			}
+
+			if snapshot.Unavailable[string(addr)] {
+				nextStart = new(big.Int).Add(value, big.NewInt(1))
+				break
+			}
+		}
+
+		if nextStart == nil {
+			return start.FillBytes(make([]byte, addrLen)), end.FillBytes(make([]byte, addrLen)), false
+		}
+
+		start = alignAddressValue(nextStart, alignmentValue)
+	}
+}
+
+/*
+	Assigns a run of consecutive generated addresses to a single name.
+	The name maps to the first address of the block and the block entry of the name holds its last address.
+
+	release quarantined addresses whose reuse delay has elapsed
+	read all of data/ in a single request
+	find the lowest run of free addresses of the given size (see findFreeBlock)
+	if the block reaches the next address of a sequential range, the free addresses between the next address and the
+	block are added to deleted/ so that they can still be assigned
+	fail if the transaction exceeds the operation limit of etcd (the block addresses, the skipped addresses and the other entries)
+	check during transaction:
+	  - nothing in data/ was modified since it was read
+	transaction:
+	  - remove the block addresses from deleted/ if present
+	  - add the block addresses to generated/
+	  - add name to name/ and block/
+	  - add skipped addresses to deleted/ and set next assignable address to the last block address + 1 (if the block reaches the next address)
+*/
+func (conn *EtcdConnection) createAddressBlockWithRetries(prefix string, name string, size int64, alignment int64, rangeType string, incAddr IncrementAddress, retries int) ([]byte, []byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		if !shouldRetry(addrRangeErr, retries) {
+			return []byte{}, []byte{}, addrRangeErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, retries - 1)
+	}
+	if !addrRangeExists {
+		return []byte{}, []byte{}, errors.New(fmt.Sprintf("Error creating address block in range with prefix '%s': Range does not exist", prefix))
+	}
+	if addrRange.Type != rangeType {
+		return []byte{}, []byte{}, errors.New(fmt.Sprintf("Error creating address block in range with prefix '%s': Range type doesn't match the created address type", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return []byte{}, []byte{}, reuseDelayErr
+	}
+
+	releaseErr := conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, 0)
+	if releaseErr != nil {
+		if !shouldRetry(releaseErr, retries) {
+			return []byte{}, []byte{}, releaseErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, retries - 1)
+	}
+
+	snapshot, snapshotErr := conn.getBatchAllocationSnapshot(prefix)
+	if snapshotErr != nil {
+		if !shouldRetry(snapshotErr, retries) {
+			return []byte{}, []byte{}, snapshotErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, retries - 1)
+	}
+
+	if _, exists := snapshot.Names[name]; exists {
+		return []byte{}, []byte{}, errors.New(fmt.Sprintf("Error creating address block '%s': Address was already present in range with prefix '%s'", name, prefix))
+	}
+
+	firstAddr, lastAddr, full := snapshot.findFreeBlock(addrRange, size, alignment)
+	if full {
+		return []byte{}, []byte{}, errors.New(fmt.Sprintf("Error creating address block '%s': Range with prefix '%s' doesn't have %d consecutive free addresses", name, prefix, size))
+	}
+
+	ops := []clientv3.Op{}
+	for offset := int64(0); offset < size; offset++ {
+		addr := AddressAtOffset(firstAddr, big.NewInt(offset))
+		if snapshot.IsDeleted[string(addr)] {
+			ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + string(addr)))
+		}
+		ops = append(ops, clientv3.OpPut(addrKeyPrefixes.GeneratedAddress + string(addr), name))
+	}
+	ops = append(
+		ops,
+		clientv3.OpPut(addrKeyPrefixes.Name + name, string(firstAddr)),
+		clientv3.OpPut(addrKeyPrefixes.Block + name, string(lastAddr)),
+	)
+
+	if GetAllocationStrategy(addrRange) == AllocationStrategySequential && !AddressGreaterThan(snapshot.NextAddress, lastAddr) {
+		for addr := snapshot.NextAddress; AddressLessThan(addr, firstAddr); addr = incAddr(addr) {
+			if _, isExcluded := addrRange.GetExclusion(addr); isExcluded || snapshot.Unavailable[string(addr)] || snapshot.IsDeleted[string(addr)] {
+				continue
+			}
+
+			ops = append(ops, clientv3.OpPut(addrKeyPrefixes.DeletedAddress + string(addr), ""))
+			if conn.MaxTxnOps > 0 && len(ops) > conn.MaxTxnOps {
+				//The transaction is already too large, no need to look at the remaining skipped addresses
+				break
+			}
+		}
+
+		ops = append(ops, clientv3.OpPut(addrRangeKeys.NextAddress, string(incAddr(lastAddr))))
+	}
+
+	sizeErr := conn.checkTxnSize(1, len(ops), fmt.Sprintf("creating address block '%s' of %d addresses in range with prefix '%s'", name, size, prefix))
+	if sizeErr != nil {
+		return []byte{}, []byte{}, sizeErr
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", snapshot.Revision + 1).WithPrefix(),
+	).Then(ops...)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return []byte{}, []byte{}, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return []byte{}, []byte{}, errors.New("Failed to create address block: Range was modified concurrently")
+		}
+
+		return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, retries - 1)
+	}
+
+	return firstAddr, lastAddr, nil
+}
+
+//The first address of the block is a multiple of the alignment
+func (conn *EtcdConnection) CreateAddressBlock(prefix string, name string, size int64, alignment int64, rangeType string, incAddr IncrementAddress) ([]byte, []byte, error) {
+	if size < 1 {
+		return []byte{}, []byte{}, errors.New("Error creating address block: Size must be positive")
+	}
+
+	if alignment < 1 || alignment & (alignment - 1) != 0 {
+		return []byte{}, []byte{}, errors.New("Error creating address block: Alignment must be a power of 2")
+	}
+
+	//Each address of the block takes an operation, plus the name/ and block/ entries
+	sizeErr := conn.checkTxnSize(1, int(size) + 2, fmt.Sprintf("creating address block '%s' of %d addresses in range with prefix '%s'", name, size, prefix))
+	if sizeErr != nil {
+		return []byte{}, []byte{}, sizeErr
+	}
+
+	return conn.createAddressBlockWithRetries(prefix, name, size, alignment, rangeType, incAddr, conn.Retries)
+}
+
+//Returns the first and last address of the block assigned to the name
+func (conn *EtcdConnection) getAddressBlockWithRetries(prefix string, name string, retries int) ([]byte, []byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	resp, err := conn.Client.Txn(ctx).Then(
+		clientv3.OpGet(addrKeyPrefixes.Name + name),
+		clientv3.OpGet(addrKeyPrefixes.Block + name),
+	).Commit()
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return []byte{}, []byte{}, false, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getAddressBlockWithRetries(prefix, name, retries - 1)
+	}
+
+	nameKvs := resp.Responses[0].GetResponseRange().Kvs
+	blockKvs := resp.Responses[1].GetResponseRange().Kvs
+	if len(nameKvs) == 0 || len(blockKvs) == 0 {
+		return []byte{}, []byte{}, false, nil
+	}
+
+	return nameKvs[0].Value, blockKvs[0].Value, true, nil
+}
+
+func (conn *EtcdConnection) GetAddressBlock(prefix string, name string) ([]byte, []byte, bool, error) {
+	return conn.getAddressBlockWithRetries(prefix, name, conn.Retries)
+}
+
+/*
+	check during transaction:
+	  - name is present in name/ and block/ with the expected first and last addresses
+	transaction:
+	  - remove block addresses from generated/
+	  - remove name from name/ and block/
+	  - add block addresses to deleted/ (or to quarantine/ if the range has a reuse delay)
+*/
+func (conn *EtcdConnection) deleteAddressBlockWithRetries(prefix string, name string, firstAddr []byte, lastAddr []byte, prettify PrettifyAddr, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, retries)
+	if addrRangeErr != nil {
+		return addrRangeErr
+	}
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error deleting address block in range with prefix '%s': Range does not exist", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return reuseDelayErr
+	}
+
+	ops := []clientv3.Op{
+		clientv3.OpDelete(addrKeyPrefixes.Name + name),
+		clientv3.OpDelete(addrKeyPrefixes.Block + name),
+	}
+	size := AddressRangeSize(firstAddr, lastAddr).Int64()
+	for offset := int64(0); offset < size; offset++ {
+		addr := AddressAtOffset(firstAddr, big.NewInt(offset))
+		freedKey, freedValue := freedAddressEntry(prefix, name, addr, reuseDelay)
+		ops = append(
+			ops,
+			clientv3.OpDelete(addrKeyPrefixes.GeneratedAddress + string(addr)),
+			clientv3.OpPut(freedKey, freedValue),
+		)
+	}
+
+	sizeErr := conn.checkTxnSize(2, len(ops), fmt.Sprintf("deleting address block '%s' of %d addresses in range with prefix '%s'", name, size, prefix))
+	if sizeErr != nil {
+		return sizeErr
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		clientv3.Compare(clientv3.Value(addrKeyPrefixes.Name + name), "=", string(firstAddr)),
+		clientv3.Compare(clientv3.Value(addrKeyPrefixes.Block + name), "=", string(lastAddr)),
+	).Then(ops...)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteAddressBlockWithRetries(prefix, name, firstAddr, lastAddr, prettify, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		return errors.New(fmt.Sprintf("Failed to delete address block '%s' to '%s': Block was not assigned to the name or was already deleted", prettify(firstAddr), prettify(lastAddr)))
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) DeleteAddressBlock(prefix string, name string, firstAddr []byte, lastAddr []byte, prettify PrettifyAddr) error {
+	return conn.deleteAddressBlockWithRetries(prefix, name, firstAddr, lastAddr, prettify, conn.Retries)
+}
@@ -0,0 +1,69 @@
+package address
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAlignAddressValue(t *testing.T) {
+	tests := []struct {
+		value int64
+		alignment int64
+		expected int64
+	}{
+		{0, 4, 0},
+		{1, 4, 4},
+		{4, 4, 4},
+		{5, 4, 8},
+		{7, 1, 7},
+		{17, 16, 32},
+	}
+
+	for _, test := range tests {
+		aligned := alignAddressValue(big.NewInt(test.value), big.NewInt(test.alignment))
+		if aligned.Int64() != test.expected {
+			t.Errorf("Expected %d aligned on %d to be %d and it was %d", test.value, test.alignment, test.expected, aligned.Int64())
+		}
+	}
+}
+
+func TestFindFreeBlock(t *testing.T) {
+	tests := []struct {
+		name string
+		size int64
+		alignment int64
+		unavailable []string
+		exclusions [][2]string
+		expectedFirstAddr string
+		expectedLastAddr string
+		expectedFull bool
+	}{
+		{"lowest run", 4, 1, []string{}, [][2]string{}, "10.0.0.1", "10.0.0.4", false},
+		{"aligned run", 4, 4, []string{}, [][2]string{}, "10.0.0.4", "10.0.0.7", false},
+		{"run broken by an exclusion", 4, 1, []string{}, [][2]string{{"10.0.0.3", "10.0.0.5"}}, "10.0.0.6", "10.0.0.9", false},
+		{"run broken by an unavailable address", 4, 1, []string{"10.0.0.2"}, [][2]string{}, "10.0.0.3", "10.0.0.6", false},
+		{"aligned run broken by an unavailable address", 4, 4, []string{"10.0.0.6"}, [][2]string{}, "10.0.0.8", "10.0.0.11", false},
+		{"range without a large enough run", 4, 1, []string{"10.0.0.4", "10.0.0.8", "10.0.0.12"}, [][2]string{}, "", "", true},
+		{"range without a large enough aligned run", 8, 8, []string{}, [][2]string{}, "", "", true},
+		{"block larger than the range", 15, 1, []string{}, [][2]string{}, "", "", true},
+	}
+
+	for _, test := range tests {
+		addrRange := getTestIpv4Range(t, "10.0.0.1", "10.0.0.14", AllocationStrategySequential, test.exclusions)
+		snapshot := getTestSnapshot(t, "10.0.0.1", test.unavailable, []string{})
+
+		firstAddr, lastAddr, full := snapshot.findFreeBlock(addrRange, test.size, test.alignment)
+		if full != test.expectedFull {
+			t.Errorf("Expected range to lack a free block to be %t for '%s' and it was %t", test.expectedFull, test.name, full)
+			continue
+		}
+
+		if full {
+			continue
+		}
+
+		if Ipv4BytesToString(firstAddr) != test.expectedFirstAddr || Ipv4BytesToString(lastAddr) != test.expectedLastAddr {
+			t.Errorf("Expected block for '%s' to be %s-%s and it was %s-%s", test.name, test.expectedFirstAddr, test.expectedLastAddr, Ipv4BytesToString(firstAddr), Ipv4BytesToString(lastAddr))
+		}
+	}
+}
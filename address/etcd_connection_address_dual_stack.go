@@ -0,0 +1,348 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+	Returns the conditions and operations of the transaction creating a hardcoded address, to be combined with other
+	operations. A name that already has the same hardcoded address keeps it if present addresses are tolerated (and is
+	returned as existing).
+
+	check before transaction:
+	  - mac address complies with the oui and policy of the range
+	  - address is within the range
+	  - address is not within an excluded sub-range of the range
+	  - address is absent from hardcoded/ and generated/
+	check during transaction:
+	  - nothing in data/ was modified since it was read
+	transaction:
+	  - remove address from deleted/ or quarantine/ if it was there
+	  - add address to hardcoded/
+	  - add name to name/
+*/
+func (conn *EtcdConnection) getHardcodedAddressTransaction(prefix string, name string, address []byte, rangeType string, toleratePresent bool, prettify PrettifyAddr) (bool, []clientv3.Cmp, []clientv3.Op, error) {
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, addrRangeErr
+	}
+	if !addrRangeExists {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address in range with prefix '%s': Range does not exist", prefix))
+	}
+	if addrRange.Type != rangeType {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address in range with prefix '%s': Range type doesn't match the created address type", prefix))
+	}
+
+	policyErr := addrRange.ValidateMacAddress(address, prettify)
+	if policyErr != nil {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address '%s': %s", prettify(address), policyErr.Error()))
+	}
+
+	if !AddressWithinBoundaries(address, addrRange.FirstAddress, addrRange.LastAddress) {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address '%s': Address is outside of range boundaries", prettify(address)))
+	}
+
+	if _, isExcluded := addrRange.GetExclusion(address); isExcluded {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address '%s': Address is within an excluded sub-range of the range", prettify(address)))
+	}
+
+	snapshot, snapshotErr := conn.getBatchAllocationSnapshot(prefix)
+	if snapshotErr != nil {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, snapshotErr
+	}
+
+	conds := []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", snapshot.Revision + 1).WithPrefix(),
+	}
+
+	if existingAddr, exists := snapshot.Names[name]; exists {
+		if !toleratePresent {
+			return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address '%s': Address was already present in range with prefix '%s'", name, prefix))
+		}
+
+		if !snapshot.Hardcoded[string(existingAddr)] {
+			return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address in range with prefix '%s': An existing address with the same name didn't match the expected hardcoded setting", prefix))
+		}
+
+		if !bytes.Equal(existingAddr, address) {
+			return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error creating hardcoded address in range with prefix '%s': An existing address with the same name didn't match the expected address value", prefix))
+		}
+
+		return true, conds, []clientv3.Op{}, nil
+	}
+
+	if snapshot.Unavailable[string(address)] && !snapshot.Quarantined[string(address)] {
+		return false, []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Failed to create hardcoded address '%s': Address is already in use", prettify(address)))
+	}
+
+	ops := []clientv3.Op{}
+	if snapshot.IsDeleted[string(address)] {
+		ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + string(address)))
+	}
+	if snapshot.Quarantined[string(address)] {
+		ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.QuarantinedAddress + string(address)))
+	}
+	ops = append(
+		ops,
+		clientv3.OpPut(addrKeyPrefixes.HardcodedAddress + string(address), name),
+		clientv3.OpPut(addrKeyPrefixes.Name + name, string(address)),
+	)
+
+	return false, conds, ops, nil
+}
+
+/*
+	Ipv6 address sharing the host bits of the ipv4 address: the last 32 bits of the first address of the ipv6 range are
+	replaced by the bits of the ipv4 address past the prefix length of its range (all its bits if the ipv4 range
+	wasn't created from a cidr)
+*/
+func (conn *EtcdConnection) deriveIpv6Address(ipv4Prefix string, ipv6Prefix string, ipv4 []byte) ([]byte, error) {
+	ipv4Range, ipv4RangeExists, ipv4RangeErr := conn.getAddrRangeWithRetries(ipv4Prefix, 0)
+	if ipv4RangeErr != nil {
+		return []byte{}, ipv4RangeErr
+	}
+	if !ipv4RangeExists {
+		return []byte{}, errors.New(fmt.Sprintf("Error deriving ipv6 address from range with prefix '%s': Range does not exist", ipv4Prefix))
+	}
+
+	ipv6Range, ipv6RangeExists, ipv6RangeErr := conn.getAddrRangeWithRetries(ipv6Prefix, 0)
+	if ipv6RangeErr != nil {
+		return []byte{}, ipv6RangeErr
+	}
+	if !ipv6RangeExists {
+		return []byte{}, errors.New(fmt.Sprintf("Error deriving ipv6 address in range with prefix '%s': Range does not exist", ipv6Prefix))
+	}
+
+	prefixLength := 0
+	if attribute, ok := ipv4Range.Attributes["prefix_length"]; ok && attribute != "" {
+		var parseErr error
+		prefixLength, parseErr = strconv.Atoi(attribute)
+		if parseErr != nil {
+			return []byte{}, errors.New(fmt.Sprintf("Error parsing prefix length of range with prefix '%s': %s", ipv4Prefix, parseErr.Error()))
+		}
+	}
+
+	return Ipv4HostBitsToIpv6(ipv6Range.FirstAddress, ipv4, prefixLength), nil
+}
+
+/*
+	Allocates a generated ipv4 address and an ipv6 address to the same name in a single transaction. The ipv6 address is
+	either generated or, if it is derived from the ipv4 address (see deriveIpv6Address), hardcoded.
+	The conditions and operations of each range are those of getGeneratedAddressBatchTransaction and getHardcodedAddressTransaction.
+	Returns the addresses along with whether each already existed.
+*/
+func (conn *EtcdConnection) createDualStackAddressesWithRetries(name string, ipv4Prefix string, ipv6Prefix string, deriveIpv6 bool, toleratePresent bool, retries int) ([]byte, []byte, bool, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	ipv4Addresses, ipv4Existing, ipv4Conds, ipv4Ops, ipv4Err := conn.getGeneratedAddressBatchTransaction(ipv4Prefix, []string{name}, "ipv4", toleratePresent, AddressGreaterThan, IncAddressBy1)
+	if ipv4Err != nil {
+		if !shouldRetry(ipv4Err, retries) {
+			return []byte{}, []byte{}, false, false, ipv4Err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+	}
+	ipv4 := ipv4Addresses[name]
+
+	var ipv6 []byte
+	var ipv6Existing bool
+	var ipv6Conds []clientv3.Cmp
+	var ipv6Ops []clientv3.Op
+	if deriveIpv6 {
+		derived, deriveErr := conn.deriveIpv6Address(ipv4Prefix, ipv6Prefix, ipv4)
+		if deriveErr != nil {
+			if !shouldRetry(deriveErr, retries) {
+				return []byte{}, []byte{}, false, false, deriveErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+		}
+
+		var ipv6Err error
+		ipv6 = derived
+		ipv6Existing, ipv6Conds, ipv6Ops, ipv6Err = conn.getHardcodedAddressTransaction(ipv6Prefix, name, ipv6, "ipv6", toleratePresent, Ipv6BytesToString)
+		if ipv6Err != nil {
+			if !shouldRetry(ipv6Err, retries) {
+				return []byte{}, []byte{}, false, false, ipv6Err
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+		}
+	} else {
+		ipv6Addresses, ipv6ExistingNames, conds, ops, ipv6Err := conn.getGeneratedAddressBatchTransaction(ipv6Prefix, []string{name}, "ipv6", toleratePresent, AddressGreaterThan, IncAddressBy1)
+		if ipv6Err != nil {
+			if !shouldRetry(ipv6Err, retries) {
+				return []byte{}, []byte{}, false, false, ipv6Err
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+		}
+
+		ipv6 = ipv6Addresses[name]
+		ipv6Existing = ipv6ExistingNames[name]
+		ipv6Conds = conds
+		ipv6Ops = ops
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(append(ipv4Conds, ipv6Conds...)...).Then(append(ipv4Ops, ipv6Ops...)...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return []byte{}, []byte{}, false, false, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return []byte{}, []byte{}, false, false, errors.New("Failed to create dual stack addresses: Ranges were modified concurrently")
+		}
+
+		return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, retries - 1)
+	}
+
+	return ipv4, ipv6, ipv4Existing[name], ipv6Existing, nil
+}
+
+func (conn *EtcdConnection) CreateDualStackAddresses(name string, ipv4Prefix string, ipv6Prefix string, deriveIpv6 bool, toleratePresent bool) ([]byte, []byte, bool, bool, error) {
+	return conn.createDualStackAddressesWithRetries(name, ipv4Prefix, ipv6Prefix, deriveIpv6, toleratePresent, conn.Retries)
+}
+
+/*
+	Returns the conditions and operations of the transaction deleting a hardcoded address, to be combined with other
+	operations. Nothing is returned for a name that is not assigned if missing addresses are tolerated.
+
+	check during transaction:
+	  - nothing in data/ was modified since it was read
+	transaction:
+	  - delete address from hardcoded/
+	  - delete name from name/
+	  - add address to deleted/ (or quarantine/ if the range has a reuse delay) if it is less than the next address
+*/
+func (conn *EtcdConnection) getHardcodedAddressRemovalTransaction(prefix string, name string, address []byte, tolerateMissing bool, prettify PrettifyAddr) ([]clientv3.Cmp, []clientv3.Op, error) {
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, addrRangeErr
+	}
+	if !addrRangeExists {
+		return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting hardcoded address in range with prefix '%s': Range does not exist", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, reuseDelayErr
+	}
+
+	snapshot, snapshotErr := conn.getBatchAllocationSnapshot(prefix)
+	if snapshotErr != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, snapshotErr
+	}
+
+	existingAddr, exists := snapshot.Names[name]
+	if !exists {
+		if !tolerateMissing {
+			return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting address '%s' in range at prefix '%s': Address was not found in range", name, prefix))
+		}
+
+		return []clientv3.Cmp{}, []clientv3.Op{}, nil
+	}
+
+	if !bytes.Equal(existingAddr, address) || !snapshot.Hardcoded[string(address)] {
+		return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Error deleting hardcoded address '%s' in range at prefix '%s': Address didn't match the expected hardcoded address", prettify(address), prefix))
+	}
+
+	ops := []clientv3.Op{
+		clientv3.OpDelete(addrKeyPrefixes.HardcodedAddress + string(address)),
+		clientv3.OpDelete(addrKeyPrefixes.Name + name),
+	}
+	if AddressLessThan(address, snapshot.NextAddress) {
+		freedKey, freedValue := freedAddressEntry(prefix, name, address, reuseDelay)
+		ops = append(ops, clientv3.OpPut(freedKey, freedValue))
+	}
+
+	return []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", snapshot.Revision + 1).WithPrefix(),
+	}, ops, nil
+}
+
+/*
+	Frees the ipv4 and ipv6 addresses of a name in a single transaction (see getGeneratedAddressBatchRemovalTransaction
+	and getHardcodedAddressRemovalTransaction for the ipv6 address if it was derived from the ipv4 address).
+*/
+func (conn *EtcdConnection) deleteDualStackAddressesWithRetries(name string, ipv4Prefix string, ipv4 []byte, ipv6Prefix string, ipv6 []byte, ipv6IsHardcoded bool, tolerateMissing bool, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	ipv4Conds, ipv4Ops, ipv4Err := conn.getGeneratedAddressBatchRemovalTransaction(ipv4Prefix, map[string][]byte{name: ipv4}, tolerateMissing)
+	if ipv4Err != nil {
+		if !shouldRetry(ipv4Err, retries) {
+			return ipv4Err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteDualStackAddressesWithRetries(name, ipv4Prefix, ipv4, ipv6Prefix, ipv6, ipv6IsHardcoded, tolerateMissing, retries - 1)
+	}
+
+	var ipv6Conds []clientv3.Cmp
+	var ipv6Ops []clientv3.Op
+	var ipv6Err error
+	if ipv6IsHardcoded {
+		ipv6Conds, ipv6Ops, ipv6Err = conn.getHardcodedAddressRemovalTransaction(ipv6Prefix, name, ipv6, tolerateMissing, Ipv6BytesToString)
+	} else {
+		ipv6Conds, ipv6Ops, ipv6Err = conn.getGeneratedAddressBatchRemovalTransaction(ipv6Prefix, map[string][]byte{name: ipv6}, tolerateMissing)
+	}
+	if ipv6Err != nil {
+		if !shouldRetry(ipv6Err, retries) {
+			return ipv6Err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteDualStackAddressesWithRetries(name, ipv4Prefix, ipv4, ipv6Prefix, ipv6, ipv6IsHardcoded, tolerateMissing, retries - 1)
+	}
+
+	ops := append(ipv4Ops, ipv6Ops...)
+	if len(ops) == 0 {
+		return nil
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(append(ipv4Conds, ipv6Conds...)...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteDualStackAddressesWithRetries(name, ipv4Prefix, ipv4, ipv6Prefix, ipv6, ipv6IsHardcoded, tolerateMissing, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New("Failed to delete dual stack addresses: Addresses were modified concurrently")
+		}
+
+		return conn.deleteDualStackAddressesWithRetries(name, ipv4Prefix, ipv4, ipv6Prefix, ipv6, ipv6IsHardcoded, tolerateMissing, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) DeleteDualStackAddresses(name string, ipv4Prefix string, ipv4 []byte, ipv6Prefix string, ipv6 []byte, ipv6IsHardcoded bool, tolerateMissing bool) error {
+	return conn.deleteDualStackAddressesWithRetries(name, ipv4Prefix, ipv4, ipv6Prefix, ipv6, ipv6IsHardcoded, tolerateMissing, conn.Retries)
+}
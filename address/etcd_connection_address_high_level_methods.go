@@ -47,7 +47,21 @@ func (conn *EtcdConnection) GenerateGeneratedAddressWithValidation(name string,
 		if addrRange.Type != rangeType {
 			return false, []byte{}, "", errors.New(fmt.Sprintf("Error creating address in range with prefix '%s': Range type doesn't match the created address type", prefix))
 		}
+	}
+
+	//Give the name back the address it previously held if it is still free
+	for _, prefix := range prefixes {
+		reclaimedAddr, reclaimed, reclaimErr := conn.reclaimGeneratedAddressWithRetries(prefix, prefixes, name, conn.Retries)
+		if reclaimErr != nil {
+			return false, []byte{}, "", reclaimErr
+		}
 
+		if reclaimed {
+			return addrDetExists, reclaimedAddr, prefix, nil
+		}
+	}
+
+	for _, prefix := range prefixes {
 		genAddr, full, genErr := conn.createGeneratedAddressWithRetries(prefix, prefixes, name, addrIsGreater, incAddr, conn.Retries)
 		if genErr != nil {
 			return false, []byte{}, "", genErr
@@ -0,0 +1,140 @@
+package address
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func encodeLabels(labels map[string]string) (string, error) {
+	encoded, err := json.Marshal(labels)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Error encoding labels: %s", err.Error()))
+	}
+
+	return string(encoded), nil
+}
+
+func decodeLabels(value []byte) (map[string]string, error) {
+	labels := map[string]string{}
+	err := json.Unmarshal(value, &labels)
+	if err != nil {
+		return map[string]string{}, errors.New(fmt.Sprintf("Error decoding labels '%s': %s", string(value), err.Error()))
+	}
+
+	return labels, nil
+}
+
+/*
+	check during transaction:
+	  - name exists in name/
+	transaction:
+	  - set the labels of the name in labels/ (or delete them if there are no labels)
+*/
+func (conn *EtcdConnection) setAddressLabelsWithRetries(prefix string, name string, labels map[string]string, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	labelsOp := clientv3.OpDelete(addrKeyPrefixes.Labels + name)
+	if len(labels) > 0 {
+		encoded, encodeErr := encodeLabels(labels)
+		if encodeErr != nil {
+			return encodeErr
+		}
+
+		labelsOp = clientv3.OpPut(addrKeyPrefixes.Labels + name, encoded)
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(
+		clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), ">", 0),
+	).Then(
+		labelsOp,
+	).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.setAddressLabelsWithRetries(prefix, name, labels, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		return errors.New(fmt.Sprintf("Failed to set labels of address '%s' in range at prefix '%s': Address was not found in range", name, prefix))
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) SetAddressLabels(prefix string, name string, labels map[string]string) error {
+	return conn.setAddressLabelsWithRetries(prefix, name, labels, conn.Retries)
+}
+
+func (conn *EtcdConnection) getAddressLabelsWithRetries(prefix string, name string, retries int) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.Labels + name)
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return map[string]string{}, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getAddressLabelsWithRetries(prefix, name, retries - 1)
+	}
+
+	if len(getRes.Kvs) == 0 {
+		return map[string]string{}, nil
+	}
+
+	return decodeLabels(getRes.Kvs[0].Value)
+}
+
+//Names without labels have an empty map
+func (conn *EtcdConnection) GetAddressLabels(prefix string, name string) (map[string]string, error) {
+	return conn.getAddressLabelsWithRetries(prefix, name, conn.Retries)
+}
+
+func (conn *EtcdConnection) getAddressLabelsListWithRetries(prefix string, retries int) (map[string]map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.Labels, clientv3.WithPrefix())
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return map[string]map[string]string{}, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getAddressLabelsListWithRetries(prefix, retries - 1)
+	}
+
+	labelsList := map[string]map[string]string{}
+	for _, kv := range getRes.Kvs {
+		labels, decodeErr := decodeLabels(kv.Value)
+		if decodeErr != nil {
+			return map[string]map[string]string{}, decodeErr
+		}
+
+		labelsList[strings.TrimPrefix(string(kv.Key), addrKeyPrefixes.Labels)] = labels
+	}
+
+	return labelsList, nil
+}
+
+//Labels of all the names of the range that have labels, by name
+func (conn *EtcdConnection) GetAddressLabelsList(prefix string) (map[string]map[string]string, error) {
+	return conn.getAddressLabelsListWithRetries(prefix, conn.Retries)
+}
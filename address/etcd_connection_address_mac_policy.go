@@ -0,0 +1,99 @@
+package address
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+const (
+	MacOuiAttribute = "oui"
+	MacPolicyAttribute = "mac_policy"
+	MacPolicyLocallyAdministeredUnicast = "locally_administered_unicast"
+)
+
+//Oui all the addresses of a mac range are prefixed with. Ranges without the attribute are not restricted to an oui
+func GetMacOui(addrRange AddressRange) ([]byte, bool, error) {
+	oui, ok := addrRange.Attributes[MacOuiAttribute]
+	if !ok || oui == "" {
+		return []byte{}, false, nil
+	}
+
+	ouiBytes, err := MacOuiStringToBytes(oui)
+	if err != nil {
+		return []byte{}, false, errors.New(fmt.Sprintf("Error parsing oui of range: %s", err.Error()))
+	}
+
+	return ouiBytes, true, nil
+}
+
+//Ranges without the attribute accept any mac address
+func GetMacPolicy(addrRange AddressRange) string {
+	return addrRange.Attributes[MacPolicyAttribute]
+}
+
+/*
+	Mac addresses of a range with an oui must be unicast and prefixed with the oui. Mac addresses of a range with the
+	locally administered unicast policy must have their U/L bit set and their I/G bit unset.
+	Always succeeds for ranges of other types.
+*/
+func (addrRange AddressRange) ValidateMacAddress(addr []byte, prettify PrettifyAddr) error {
+	if addrRange.Type != "mac" {
+		return nil
+	}
+
+	oui, hasOui, ouiErr := GetMacOui(addrRange)
+	if ouiErr != nil {
+		return ouiErr
+	}
+
+	if hasOui && !bytes.Equal(addr[:3], oui) {
+		return errors.New(fmt.Sprintf("Address '%s' is not prefixed with the oui '%s' of the range", prettify(addr), MacOuiBytesToString(oui)))
+	}
+
+	policy := GetMacPolicy(addrRange)
+	if (hasOui || policy == MacPolicyLocallyAdministeredUnicast) && !MacIsUnicast(addr) {
+		return errors.New(fmt.Sprintf("Address '%s' is a multicast address and the range only has unicast addresses", prettify(addr)))
+	}
+
+	if policy == MacPolicyLocallyAdministeredUnicast && !MacIsLocallyAdministered(addr) {
+		return errors.New(fmt.Sprintf("Address '%s' is a globally administered address and the range only has locally administered addresses", prettify(addr)))
+	}
+
+	return nil
+}
+
+/*
+	Generated addresses are always within the range boundaries, so every address of the range complies with its oui and
+	policy if both boundaries do and share the same first byte (which holds the U/L and I/G bits)
+*/
+func (addrRange AddressRange) ValidateMacPolicy(prettify PrettifyAddr) error {
+	if addrRange.Type != "mac" {
+		return nil
+	}
+
+	_, hasOui, ouiErr := GetMacOui(addrRange)
+	if ouiErr != nil {
+		return ouiErr
+	}
+
+	if !hasOui && GetMacPolicy(addrRange) == "" {
+		return nil
+	}
+
+	firstErr := addrRange.ValidateMacAddress(addrRange.FirstAddress, prettify)
+	if firstErr != nil {
+		return firstErr
+	}
+
+	lastErr := addrRange.ValidateMacAddress(addrRange.LastAddress, prettify)
+	if lastErr != nil {
+		return lastErr
+	}
+
+	if addrRange.FirstAddress[0] != addrRange.LastAddress[0] {
+		return errors.New(fmt.Sprintf("First address '%s' and last address '%s' of the range must share the same first byte for all the addresses in between to comply with the policy of the range", prettify(addrRange.FirstAddress), prettify(addrRange.LastAddress)))
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+//Generated address to allocate to a name in a range, as part of an allocation spanning several ranges
+type GeneratedAddressRequest struct {
+	Prefix string
+	RangeType string
+	Name string
+	AddrIsGreater AddressIsGreater
+	IncAddr IncrementAddress
+}
+
+//Generated address to free in a range, as part of a removal spanning several ranges
+type GeneratedAddressRemoval struct {
+	Prefix string
+	Name string
+	Address []byte
+}
+
+func validateDistinctPrefixes(prefixes []string) error {
+	for idx, prefix := range prefixes {
+		if slices.Contains(prefixes[idx+1:], prefix) {
+			return errors.New(fmt.Sprintf("Range with prefix '%s' is used more than once", prefix))
+		}
+	}
+
+	return nil
+}
+
+/*
+	Allocates generated addresses in several ranges in a single transaction, so that either all the addresses are
+	allocated or none is. The conditions and operations of each range are the same as for a batch of one name in
+	the range (see getGeneratedAddressBatchTransaction).
+	Returns the addresses in the order of the requests along with whether each address already existed.
+*/
+func (conn *EtcdConnection) createGeneratedAddressesInRangesWithRetries(requests []GeneratedAddressRequest, toleratePresent bool, retries int) ([][]byte, []bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addresses := make([][]byte, len(requests))
+	existing := make([]bool, len(requests))
+	conds := []clientv3.Cmp{}
+	ops := []clientv3.Op{}
+	for idx, request := range requests {
+		reqAddresses, reqExisting, reqConds, reqOps, txBuildErr := conn.getGeneratedAddressBatchTransaction(request.Prefix, []string{request.Name}, request.RangeType, toleratePresent, request.AddrIsGreater, request.IncAddr)
+		if txBuildErr != nil {
+			if !shouldRetry(txBuildErr, retries) {
+				return [][]byte{}, []bool{}, txBuildErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.createGeneratedAddressesInRangesWithRetries(requests, toleratePresent, retries - 1)
+		}
+
+		addresses[idx] = reqAddresses[request.Name]
+		existing[idx] = reqExisting[request.Name]
+		conds = append(conds, reqConds...)
+		ops = append(ops, reqOps...)
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return [][]byte{}, []bool{}, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedAddressesInRangesWithRetries(requests, toleratePresent, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return [][]byte{}, []bool{}, errors.New("Failed to create generated addresses: Ranges were modified concurrently")
+		}
+
+		return conn.createGeneratedAddressesInRangesWithRetries(requests, toleratePresent, retries - 1)
+	}
+
+	return addresses, existing, nil
+}
+
+func (conn *EtcdConnection) CreateGeneratedAddressesInRanges(requests []GeneratedAddressRequest, toleratePresent bool) ([][]byte, []bool, error) {
+	prefixes := []string{}
+	for _, request := range requests {
+		prefixes = append(prefixes, request.Prefix)
+	}
+
+	prefixesErr := validateDistinctPrefixes(prefixes)
+	if prefixesErr != nil {
+		return [][]byte{}, []bool{}, errors.New(fmt.Sprintf("Error creating generated addresses: %s", prefixesErr.Error()))
+	}
+
+	return conn.createGeneratedAddressesInRangesWithRetries(requests, toleratePresent, conn.Retries)
+}
+
+/*
+	Frees generated addresses in several ranges in a single transaction, so that either all the addresses are freed or
+	none is (see getGeneratedAddressBatchRemovalTransaction). Addresses that are not assigned are skipped if missing
+	addresses are tolerated.
+*/
+func (conn *EtcdConnection) deleteGeneratedAddressesInRangesWithRetries(removals []GeneratedAddressRemoval, tolerateMissing bool, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	conds := []clientv3.Cmp{}
+	ops := []clientv3.Op{}
+	for _, removal := range removals {
+		removalConds, removalOps, txBuildErr := conn.getGeneratedAddressBatchRemovalTransaction(removal.Prefix, map[string][]byte{removal.Name: removal.Address}, tolerateMissing)
+		if txBuildErr != nil {
+			if !shouldRetry(txBuildErr, retries) {
+				return txBuildErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.deleteGeneratedAddressesInRangesWithRetries(removals, tolerateMissing, retries - 1)
+		}
+
+		conds = append(conds, removalConds...)
+		ops = append(ops, removalOps...)
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteGeneratedAddressesInRangesWithRetries(removals, tolerateMissing, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New("Failed to delete generated addresses: Addresses were modified concurrently")
+		}
+
+		return conn.deleteGeneratedAddressesInRangesWithRetries(removals, tolerateMissing, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) DeleteGeneratedAddressesInRanges(removals []GeneratedAddressRemoval, tolerateMissing bool) error {
+	prefixes := []string{}
+	for _, removal := range removals {
+		prefixes = append(prefixes, removal.Prefix)
+	}
+
+	prefixesErr := validateDistinctPrefixes(prefixes)
+	if prefixesErr != nil {
+		return errors.New(fmt.Sprintf("Error deleting generated addresses: %s", prefixesErr.Error()))
+	}
+
+	return conn.deleteGeneratedAddressesInRangesWithRetries(removals, tolerateMissing, conn.Retries)
+}
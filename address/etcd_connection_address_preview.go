@@ -0,0 +1,175 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+	Predicts the address createGeneratedAddressWithRetries would pick in a sequential range without modifying anything:
+	  the smallest address in deleted/ (or in quarantine/ if its reuse delay has elapsed, as it would be released first)
+	  otherwise, the next address, incremented over excluded sub-ranges and addresses present in hardcoded/ or quarantine/
+*/
+func (conn *EtcdConnection) predictSequentialGeneratedAddressWithRetries(prefix string, addrRange AddressRange, addrIsGreater AddressIsGreater, incAddr IncrementAddress, retries int) ([]byte, bool, error) {
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return []byte{}, false, reuseDelayErr
+	}
+
+	deletedAddr, deletedAddrExists, _, deletedAddrErr := conn.getDeletedAddress(prefix)
+	if deletedAddrErr != nil {
+		if !shouldRetry(deletedAddrErr, retries) {
+			return []byte{}, false, deletedAddrErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.predictSequentialGeneratedAddressWithRetries(prefix, addrRange, addrIsGreater, incAddr, retries - 1)
+	}
+
+	quarantineList, quarantineListErr := conn.getQuarantineListWithRetries(prefix, retries)
+	if quarantineListErr != nil {
+		return []byte{}, false, quarantineListErr
+	}
+
+	now := time.Now().Unix()
+	for _, entry := range quarantineList {
+		if entry.FreedAt + reuseDelay > now {
+			continue
+		}
+
+		if !deletedAddrExists || AddressLessThan(entry.Address, deletedAddr) {
+			deletedAddr = entry.Address
+			deletedAddrExists = true
+		}
+	}
+
+	if deletedAddrExists {
+		return deletedAddr, false, nil
+	}
+
+	nextAddr, _, nextAddrErr := conn.getNextAddress(prefix)
+	if nextAddrErr != nil {
+		if !shouldRetry(nextAddrErr, retries) {
+			return []byte{}, false, nextAddrErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.predictSequentialGeneratedAddressWithRetries(prefix, addrRange, addrIsGreater, incAddr, retries - 1)
+	}
+
+	for {
+		if addrIsGreater(nextAddr, addrRange.LastAddress) {
+			//Range is full
+			return []byte{}, true, nil
+		}
+
+		exclusion, isExcluded := addrRange.GetExclusion(nextAddr)
+		if isExcluded {
+			if !AddressLessThan(exclusion.LastAddress, addrRange.LastAddress) {
+				//Range is full
+				return []byte{}, true, nil
+			}
+
+			nextAddr = incAddr(exclusion.LastAddress)
+			continue
+		}
+
+		isHardcoded, isHarcodedErr := conn.addressIsHardcoded(prefix, nextAddr)
+		if isHarcodedErr != nil {
+			if !shouldRetry(isHarcodedErr, retries) {
+				return []byte{}, false, isHarcodedErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.predictSequentialGeneratedAddressWithRetries(prefix, addrRange, addrIsGreater, incAddr, retries - 1)
+		}
+
+		isQuarantined, isQuarantinedErr := conn.addressIsQuarantined(prefix, nextAddr)
+		if isQuarantinedErr != nil {
+			if !shouldRetry(isQuarantinedErr, retries) {
+				return []byte{}, false, isQuarantinedErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.predictSequentialGeneratedAddressWithRetries(prefix, addrRange, addrIsGreater, incAddr, retries - 1)
+		}
+
+		if !isHardcoded && !isQuarantined {
+			return nextAddr, false, nil
+		}
+
+		nextAddr = incAddr(nextAddr)
+	}
+}
+
+/*
+	Best-effort prediction of the address GenerateGeneratedAddressWithValidation would assign to the name in the ranges, without
+	modifying anything. The prediction will be wrong if addresses are created or deleted in the ranges before the address is.
+	Returns false if the address can't be predicted, because one of the ranges it would be picked from has the random
+	allocation strategy or because the ranges are full.
+*/
+func (conn *EtcdConnection) PredictGeneratedAddress(name string, prefixes []string, rangeType string, addrIsGreater AddressIsGreater, incAddr IncrementAddress) ([]byte, string, bool, error) {
+	addrDetExists, _, addrDet, addrDetPrefix, detailsErr := conn.FindAddressDetailsInRanges(prefixes, name)
+	if detailsErr != nil {
+		return []byte{}, "", false, detailsErr
+	}
+
+	if addrDetExists {
+		return addrDet, addrDetPrefix, true, nil
+	}
+
+	addrRanges := make([]AddressRange, len(prefixes))
+	for idx, prefix := range prefixes {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(prefix)
+		if addrRangeErr != nil {
+			return []byte{}, "", false, addrRangeErr
+		}
+		if !addrRangeExists {
+			return []byte{}, "", false, errors.New(fmt.Sprintf("Error predicting address in range with prefix '%s': Range doesn't exist", prefix))
+		}
+		if addrRange.Type != rangeType {
+			return []byte{}, "", false, errors.New(fmt.Sprintf("Error predicting address in range with prefix '%s': Range type doesn't match the address type", prefix))
+		}
+
+		addrRanges[idx] = addrRange
+	}
+
+	for _, prefix := range prefixes {
+		freedAddr, _, _, freedAddrExists, freedAddrErr := conn.findFreedAddressByName(prefix, name)
+		if freedAddrErr != nil {
+			return []byte{}, "", false, freedAddrErr
+		}
+
+		if freedAddrExists {
+			return freedAddr, prefix, true, nil
+		}
+	}
+
+	for idx, prefix := range prefixes {
+		var addr []byte
+		var full bool
+		var err error
+
+		switch GetAllocationStrategy(addrRanges[idx]) {
+		case AllocationStrategySequential:
+			addr, full, err = conn.predictSequentialGeneratedAddressWithRetries(prefix, addrRanges[idx], addrIsGreater, incAddr, conn.Retries)
+		case AllocationStrategyHashOfName:
+			addr, full, err = conn.findSpreadFreeAddressWithRetries(prefix, addrRanges[idx], name, conn.Retries)
+		default:
+			return []byte{}, "", false, nil
+		}
+
+		if err != nil {
+			return []byte{}, "", false, err
+		}
+
+		if full {
+			continue
+		}
+
+		return addr, prefix, true, nil
+	}
+
+	return []byte{}, "", false, nil
+}
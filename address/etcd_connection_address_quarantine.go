@@ -0,0 +1,211 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const ReuseDelayAttribute = "reuse_delay"
+
+//Etcd transactions have a limit on their number of operations, so quarantined addresses are released in batches
+const quarantineReleaseBatchSize = 50
+
+type QuarantineListEntry struct {
+	Name string
+	Address []byte
+	FreedAt int64
+}
+
+//Number of seconds a freed address stays in quarantine. Ranges without the attribute have no quarantine
+func GetReuseDelay(addrRange AddressRange) (int64, error) {
+	reuseDelay, ok := addrRange.Attributes[ReuseDelayAttribute]
+	if !ok || reuseDelay == "" {
+		return 0, nil
+	}
+
+	delay, err := strconv.ParseInt(reuseDelay, 10, 64)
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Error parsing reuse delay of range: %s", err.Error()))
+	}
+
+	return delay, nil
+}
+
+func encodeQuarantineEntry(name string, freedAt int64) string {
+	return fmt.Sprintf("%d:%s", freedAt, name)
+}
+
+func decodeQuarantineEntry(value string) (string, int64, error) {
+	freedAt, name, found := strings.Cut(value, ":")
+	if !found {
+		return "", 0, errors.New(fmt.Sprintf("Error decoding quarantine entry '%s': Missing separator", value))
+	}
+
+	freedAtInt, err := strconv.ParseInt(freedAt, 10, 64)
+	if err != nil {
+		return "", 0, errors.New(fmt.Sprintf("Error decoding quarantine entry '%s': %s", value, err.Error()))
+	}
+
+	return name, freedAtInt, nil
+}
+
+/*
+	Returns the key and value a freed address should be put under: in quarantine/ with the time it was freed if the range
+	has a reuse delay, in deleted/ otherwise
+*/
+func (conn *EtcdConnection) getFreedAddressEntry(prefix string, name string, address []byte) (string, string, error) {
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		return "", "", addrRangeErr
+	}
+	if !addrRangeExists {
+		return "", "", errors.New(fmt.Sprintf("Error freeing address in range with prefix '%s': Range does not exist", prefix))
+	}
+
+	reuseDelay, reuseDelayErr := GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return "", "", reuseDelayErr
+	}
+
+	key, value := freedAddressEntry(prefix, name, address, reuseDelay)
+	return key, value, nil
+}
+
+func freedAddressEntry(prefix string, name string, address []byte, reuseDelay int64) (string, string) {
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	if reuseDelay <= 0 {
+		return addrKeyPrefixes.DeletedAddress + string(address), name
+	}
+
+	return addrKeyPrefixes.QuarantinedAddress + string(address), encodeQuarantineEntry(name, time.Now().Unix())
+}
+
+func (conn *EtcdConnection) addressIsQuarantined(prefix string, address []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.QuarantinedAddress + string(address))
+	if err != nil {
+		return false, err
+	}
+
+	return len(getRes.Kvs) > 0, nil
+}
+
+func (conn *EtcdConnection) getQuarantineListWithRetries(prefix string, retries int) ([]QuarantineListEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.QuarantinedAddress, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return []QuarantineListEntry{}, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getQuarantineListWithRetries(prefix, retries - 1)
+	}
+
+	listing := make([]QuarantineListEntry, len(getRes.Kvs))
+	for idx, val := range getRes.Kvs {
+		name, freedAt, decodeErr := decodeQuarantineEntry(string(val.Value))
+		if decodeErr != nil {
+			return []QuarantineListEntry{}, decodeErr
+		}
+
+		address, _ := bytes.CutPrefix(val.Key, []byte(addrKeyPrefixes.QuarantinedAddress))
+		listing[idx] = QuarantineListEntry{name, address, freedAt}
+	}
+
+	return listing, nil
+}
+
+func (conn *EtcdConnection) GetQuarantineList(prefix string) ([]QuarantineListEntry, error) {
+	return conn.getQuarantineListWithRetries(prefix, conn.Retries)
+}
+
+/*
+	get all addresses from quarantine/ that were freed longer than the reuse delay ago
+	by batches:
+	  check during transaction:
+	    - the addresses in quarantine/ were not modified since they were read
+	  transaction:
+	    - remove the addresses from quarantine/
+	    - add the addresses to deleted/
+	A batch failing its check means another process modified the quarantine concurrently and its addresses are left for later
+*/
+func (conn *EtcdConnection) releaseQuarantinedAddressesWithRetries(prefix string, reuseDelay int64, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Get(ctx, addrKeyPrefixes.QuarantinedAddress, clientv3.WithPrefix())
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, retries - 1)
+	}
+
+	now := time.Now().Unix()
+	conds := []clientv3.Cmp{}
+	ops := []clientv3.Op{}
+	for _, kv := range getRes.Kvs {
+		name, freedAt, decodeErr := decodeQuarantineEntry(string(kv.Value))
+		if decodeErr != nil {
+			return decodeErr
+		}
+
+		if freedAt + reuseDelay > now {
+			continue
+		}
+
+		address, _ := bytes.CutPrefix(kv.Key, []byte(addrKeyPrefixes.QuarantinedAddress))
+		conds = append(conds, clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision))
+		ops = append(ops, clientv3.OpDelete(string(kv.Key)), clientv3.OpPut(addrKeyPrefixes.DeletedAddress + string(address), name))
+
+		if len(conds) == quarantineReleaseBatchSize {
+			_, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+			if txErr != nil {
+				if !shouldRetry(txErr, retries) {
+					return txErr
+				}
+
+				time.Sleep(100 * time.Millisecond)
+				return conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, retries - 1)
+			}
+
+			conds = []clientv3.Cmp{}
+			ops = []clientv3.Op{}
+		}
+	}
+
+	if len(conds) > 0 {
+		_, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+		if txErr != nil {
+			if !shouldRetry(txErr, retries) {
+				return txErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.releaseQuarantinedAddressesWithRetries(prefix, reuseDelay, retries - 1)
+		}
+	}
+
+	return nil
+}
@@ -1,18 +1,30 @@
 package address
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
 	"time"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
+type AddressExclusion struct {
+	FirstAddress []byte
+	LastAddress  []byte
+}
+
 type AddressRange struct {
 	Type         string
 	FirstAddress []byte
 	LastAddress  []byte
+	Attributes   map[string]string
+	Exclusions   []AddressExclusion
 }
 
 type AddrRangeEtcdKeys struct {
@@ -20,40 +32,145 @@ type AddrRangeEtcdKeys struct {
 	FirstAddress string
 	LastAddress  string
 	NextAddress  string
+	Attributes   string
+	Exclusions   string
 }
 
 type AddrRangeUsage struct {
-	Capacity     int64
-	UsedCapacity int64
-	FreeCapacity int64
+	Capacity         int64
+	UsedCapacity     int64
+	ExcludedCapacity int64
+	FreeCapacity     int64
+	//Exact counts of the capacity and free capacity, for ranges (like ipv6 ranges) larger than what an int64 holds
+	ExactCapacity     *big.Int
+	ExactFreeCapacity *big.Int
 }
 
 type RangeAddressCount func([]byte, []byte) int64
 
+func saturatingAdd(value int64, other int64) int64 {
+	if value > math.MaxInt64 - other {
+		return math.MaxInt64
+	}
+
+	return value + other
+}
+
+//Nil counts, like those of an empty usage, are zero
+func exactAdd(value *big.Int, other *big.Int) *big.Int {
+	sum := new(big.Int)
+	if value != nil {
+		sum.Add(sum, value)
+	}
+	if other != nil {
+		sum.Add(sum, other)
+	}
+
+	return sum
+}
+
+//Sums the usage of two ranges, saturating at the maximum int64 value like the counts of large ranges (exact counts don't saturate)
+func (usage AddrRangeUsage) Add(other AddrRangeUsage) AddrRangeUsage {
+	return AddrRangeUsage{
+		Capacity: saturatingAdd(usage.Capacity, other.Capacity),
+		UsedCapacity: saturatingAdd(usage.UsedCapacity, other.UsedCapacity),
+		ExcludedCapacity: saturatingAdd(usage.ExcludedCapacity, other.ExcludedCapacity),
+		FreeCapacity: saturatingAdd(usage.FreeCapacity, other.FreeCapacity),
+		ExactCapacity: exactAdd(usage.ExactCapacity, other.ExactCapacity),
+		ExactFreeCapacity: exactAdd(usage.ExactFreeCapacity, other.ExactFreeCapacity),
+	}
+}
+
 func GenerateAddrRangeEtcdKeys(rangePrefix string) AddrRangeEtcdKeys {
 	return AddrRangeEtcdKeys{
 		Type: rangePrefix + "info/type",
 		FirstAddress: rangePrefix + "info/firstaddr",
 		LastAddress: rangePrefix + "info/lastaddr",
 		NextAddress: rangePrefix + "data/nextaddr",
+		Attributes: rangePrefix + "info/attributes/",
+		Exclusions: rangePrefix + "info/exclusions/",
 	}
 }
 
+//Returns the excluded sub-range the address is in, if any
+func (addrRange AddressRange) GetExclusion(addr []byte) (AddressExclusion, bool) {
+	for _, exclusion := range addrRange.Exclusions {
+		if AddressWithinBoundaries(addr, exclusion.FirstAddress, exclusion.LastAddress) {
+			return exclusion, true
+		}
+	}
+
+	return AddressExclusion{}, false
+}
+
+//Checks that excluded sub-ranges are well formed, within the range and don't overlap
+func (addrRange AddressRange) ValidateExclusions(prettify PrettifyAddr) error {
+	for idx, exclusion := range addrRange.Exclusions {
+		if AddressGreaterThan(exclusion.FirstAddress, exclusion.LastAddress) {
+			return errors.New(fmt.Sprintf("Exclusion starting at '%s' has a first address greater than its last address", prettify(exclusion.FirstAddress)))
+		}
+
+		if !AddressWithinBoundaries(exclusion.FirstAddress, addrRange.FirstAddress, addrRange.LastAddress) || !AddressWithinBoundaries(exclusion.LastAddress, addrRange.FirstAddress, addrRange.LastAddress) {
+			return errors.New(fmt.Sprintf("Exclusion starting at '%s' is outside of range boundaries", prettify(exclusion.FirstAddress)))
+		}
+
+		for _, other := range addrRange.Exclusions[idx+1:] {
+			if !AddressGreaterThan(exclusion.FirstAddress, other.LastAddress) && !AddressGreaterThan(other.FirstAddress, exclusion.LastAddress) {
+				return errors.New(fmt.Sprintf("Exclusions starting at '%s' and '%s' overlap", prettify(exclusion.FirstAddress), prettify(other.FirstAddress)))
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+	if a registry prefix is configured, check that the range doesn't overlap a registered range of the same type
+	check during transaction:
+	  - range doesn't exist
+	  - no range of the same type was registered or modified in the registry since the check for overlaps
+	transaction:
+	  - add the range keys
+	  - add the range to the registry
+*/
 func (conn *EtcdConnection) createAddrRangeWithRetries(prefix string, addrRange AddressRange, retries int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
 	defer cancel()
 
 	rangeKeys := GenerateAddrRangeEtcdKeys(prefix)
-	tx := conn.Client.Txn(ctx).If(
-		clientv3.Compare(clientv3.Version(rangeKeys.Type), "=", 0),
-		clientv3.Compare(clientv3.Version(rangeKeys.FirstAddress), "=", 0),
-		clientv3.Compare(clientv3.Version(rangeKeys.LastAddress), "=", 0),
-		clientv3.Compare(clientv3.Version(rangeKeys.NextAddress), "=", 0),
-	).Then(
+
+	registryConds, registryOps, registryErr := conn.getRegistryTransactionWithRetries(prefix, addrRange, retries)
+	if registryErr != nil {
+		return errors.New(fmt.Sprintf("Failed to create address range at prefix '%s': %s", prefix, registryErr.Error()))
+	}
+
+	ops := []clientv3.Op{
 		clientv3.OpPut(rangeKeys.Type, string(addrRange.Type)),
 		clientv3.OpPut(rangeKeys.FirstAddress, string(addrRange.FirstAddress)),
 		clientv3.OpPut(rangeKeys.LastAddress, string(addrRange.LastAddress)),
 		clientv3.OpPut(rangeKeys.NextAddress, string(addrRange.FirstAddress)),
+	}
+	for attrName, attrValue := range addrRange.Attributes {
+		ops = append(ops, clientv3.OpPut(rangeKeys.Attributes + attrName, attrValue))
+	}
+	for _, exclusion := range addrRange.Exclusions {
+		ops = append(ops, clientv3.OpPut(rangeKeys.Exclusions + string(exclusion.FirstAddress), string(exclusion.LastAddress)))
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		slices.Concat(
+			[]clientv3.Cmp{
+				clientv3.Compare(clientv3.Version(rangeKeys.Type), "=", 0),
+				clientv3.Compare(clientv3.Version(rangeKeys.FirstAddress), "=", 0),
+				clientv3.Compare(clientv3.Version(rangeKeys.LastAddress), "=", 0),
+				clientv3.Compare(clientv3.Version(rangeKeys.NextAddress), "=", 0),
+			},
+			registryConds,
+		)...
+	).Then(
+		slices.Concat(ops, registryOps)...
+	).Else(
+		clientv3.OpGet(rangeKeys.Type),
 	)
 
 	resp, err := tx.Commit()
@@ -67,6 +184,14 @@ func (conn *EtcdConnection) createAddrRangeWithRetries(prefix string, addrRange
 	}
 
 	if !resp.Succeeded {
+		if len(resp.Responses[0].GetResponseRange().Kvs) == 0 && len(registryConds) > 0 {
+			if retries <= 0 {
+				return errors.New(fmt.Sprintf("Failed to create address range at prefix '%s': Registry was modified concurrently", prefix))
+			}
+
+			return conn.createAddrRangeWithRetries(prefix, addrRange, retries - 1)
+		}
+
 		return errors.New(fmt.Sprintf("Failed to create address range at prefix '%s': An address range already exists at that prefix", prefix))
 	}
 
@@ -77,6 +202,211 @@ func (conn *EtcdConnection) CreateAddrRange(prefix string, addrRange AddressRang
 	return conn.createAddrRangeWithRetries(prefix, addrRange, conn.Retries)
 }
 
+/*
+	check during transaction:
+	  - range exists
+	transaction:
+	  - set the attributes with a non-empty value
+	  - remove the attributes with an empty value
+*/
+func (conn *EtcdConnection) updateAddrRangeAttributesWithRetries(prefix string, attributes map[string]string, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	rangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	ops := []clientv3.Op{}
+	for attrName, attrValue := range attributes {
+		if attrValue == "" {
+			ops = append(ops, clientv3.OpDelete(rangeKeys.Attributes + attrName))
+		} else {
+			ops = append(ops, clientv3.OpPut(rangeKeys.Attributes + attrName, attrValue))
+		}
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		clientv3.Compare(clientv3.Version(rangeKeys.Type), ">", 0),
+	).Then(ops...)
+
+	resp, err := tx.Commit()
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.updateAddrRangeAttributesWithRetries(prefix, attributes, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		return errors.New(fmt.Sprintf("Failed to update attributes of address range at prefix '%s': Range does not exist", prefix))
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) UpdateAddrRangeAttributes(prefix string, attributes map[string]string) error {
+	return conn.updateAddrRangeAttributesWithRetries(prefix, attributes, conn.Retries)
+}
+
+/*
+	get the range and all the entries under data/
+	check that the addresses in generated/ and hardcoded/ and the excluded sub-ranges are within the new boundaries
+	check that the new boundaries of a mac range comply with its oui and policy
+	if a registry prefix is configured, check that the new boundaries don't overlap a registered range of the same type
+	if the first address is lowered, check that the next address is still at the current first address
+	check during transaction:
+	  - first and last address of the range are unchanged
+	  - no entry under data/ was modified since it was read
+	  - no range of the same type was registered or modified in the registry since the check for overlaps
+	transaction:
+	  - set first and last address to the new boundaries
+	  - update the range boundaries in the registry
+	  - remove the addresses in deleted/ and quarantine/ that are outside the new boundaries
+	  - move the next address to the new first address if it is below it
+	  - move the next address right after the new last address if it is beyond it
+*/
+func (conn *EtcdConnection) resizeAddrRangeWithRetries(prefix string, firstAddr []byte, lastAddr []byte, prettify PrettifyAddr, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	rangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	if AddressGreaterThan(firstAddr, lastAddr) {
+		return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': First address '%s' is greater than last address '%s'", prefix, prettify(firstAddr), prettify(lastAddr)))
+	}
+
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil {
+		if !shouldRetry(addrRangeErr, retries) {
+			return addrRangeErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.resizeAddrRangeWithRetries(prefix, firstAddr, lastAddr, prettify, retries - 1)
+	}
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': Range does not exist", prefix))
+	}
+
+	resizedRange := addrRange
+	resizedRange.FirstAddress = firstAddr
+	resizedRange.LastAddress = lastAddr
+	exclusionsErr := resizedRange.ValidateExclusions(prettify)
+	if exclusionsErr != nil {
+		return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': %s", prefix, exclusionsErr.Error()))
+	}
+
+	policyErr := resizedRange.ValidateMacPolicy(prettify)
+	if policyErr != nil {
+		return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': %s", prefix, policyErr.Error()))
+	}
+
+	registryConds, registryOps, registryErr := conn.getRegistryTransactionWithRetries(prefix, resizedRange, retries)
+	if registryErr != nil {
+		return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': %s", prefix, registryErr.Error()))
+	}
+
+	getRes, err := conn.Client.Get(ctx, prefix + "data/", clientv3.WithPrefix())
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.resizeAddrRangeWithRetries(prefix, firstAddr, lastAddr, prettify, retries - 1)
+	}
+
+	var nextAddr []byte
+	ops := []clientv3.Op{
+		clientv3.OpPut(rangeKeys.FirstAddress, string(firstAddr)),
+		clientv3.OpPut(rangeKeys.LastAddress, string(lastAddr)),
+	}
+	for _, kv := range getRes.Kvs {
+		key := string(kv.Key)
+		if key == rangeKeys.NextAddress {
+			nextAddr = kv.Value
+			continue
+		}
+
+		for _, assignedPrefix := range []string{addrKeyPrefixes.GeneratedAddress, addrKeyPrefixes.HardcodedAddress} {
+			if strings.HasPrefix(key, assignedPrefix) {
+				addr := []byte(strings.TrimPrefix(key, assignedPrefix))
+				if !AddressWithinBoundaries(addr, firstAddr, lastAddr) {
+					return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': Address '%s' assigned to '%s' would be outside of the range", prefix, prettify(addr), string(kv.Value)))
+				}
+			}
+		}
+
+		for _, freedPrefix := range []string{addrKeyPrefixes.DeletedAddress, addrKeyPrefixes.QuarantinedAddress} {
+			if strings.HasPrefix(key, freedPrefix) {
+				addr := []byte(strings.TrimPrefix(key, freedPrefix))
+				if !AddressWithinBoundaries(addr, firstAddr, lastAddr) {
+					ops = append(ops, clientv3.OpDelete(key))
+				}
+			}
+		}
+	}
+
+	newNextAddr := nextAddr
+	if AddressLessThan(firstAddr, addrRange.FirstAddress) {
+		if !bytes.Equal(nextAddr, addrRange.FirstAddress) {
+			return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': First address can't be lowered once addresses have been generated from it", prefix))
+		}
+
+		newNextAddr = firstAddr
+	} else if AddressLessThan(nextAddr, firstAddr) {
+		newNextAddr = firstAddr
+	}
+
+	afterLastAddr := IncAddressBy1(lastAddr)
+	if afterLastAddr != nil && AddressGreaterThan(newNextAddr, afterLastAddr) {
+		newNextAddr = afterLastAddr
+	}
+
+	if !bytes.Equal(newNextAddr, nextAddr) {
+		ops = append(ops, clientv3.OpPut(rangeKeys.NextAddress, string(newNextAddr)))
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		slices.Concat(
+			[]clientv3.Cmp{
+				clientv3.Compare(clientv3.Value(rangeKeys.FirstAddress), "=", string(addrRange.FirstAddress)),
+				clientv3.Compare(clientv3.Value(rangeKeys.LastAddress), "=", string(addrRange.LastAddress)),
+				clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", getRes.Header.Revision + 1).WithPrefix(),
+			},
+			registryConds,
+		)...
+	).Then(
+		slices.Concat(ops, registryOps)...
+	)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.resizeAddrRangeWithRetries(prefix, firstAddr, lastAddr, prettify, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New(fmt.Sprintf("Failed to resize address range at prefix '%s': Range was modified concurrently", prefix))
+		}
+
+		return conn.resizeAddrRangeWithRetries(prefix, firstAddr, lastAddr, prettify, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) ResizeAddrRange(prefix string, firstAddr []byte, lastAddr []byte, prettify PrettifyAddr) error {
+	return conn.resizeAddrRangeWithRetries(prefix, firstAddr, lastAddr, prettify, conn.Retries)
+}
+
 func (conn *EtcdConnection) getAddrRangeWithRetries(prefix string, retries int) (AddressRange, bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
 	defer cancel()
@@ -94,22 +424,36 @@ func (conn *EtcdConnection) getAddrRangeWithRetries(prefix string, retries int)
 		return conn.getAddrRangeWithRetries(prefix, retries - 1)
 	}
 
-	if len(getRes.Kvs) != 3 {
-		return addrRange, false, nil
-	}
-
 	rangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+	addrRange.Attributes = map[string]string{}
+	foundKeys := 0
 	for _, kv := range getRes.Kvs {
 		switch string(kv.Key) {
 		case (rangeKeys.Type):
 			addrRange.Type = string(kv.Value)
+			foundKeys += 1
 		case (rangeKeys.FirstAddress):
 			addrRange.FirstAddress = kv.Value
+			foundKeys += 1
 		case (rangeKeys.LastAddress):
 			addrRange.LastAddress = kv.Value
+			foundKeys += 1
+		default:
+			if strings.HasPrefix(string(kv.Key), rangeKeys.Attributes) {
+				addrRange.Attributes[strings.TrimPrefix(string(kv.Key), rangeKeys.Attributes)] = string(kv.Value)
+			} else if strings.HasPrefix(string(kv.Key), rangeKeys.Exclusions) {
+				addrRange.Exclusions = append(addrRange.Exclusions, AddressExclusion{
+					FirstAddress: []byte(strings.TrimPrefix(string(kv.Key), rangeKeys.Exclusions)),
+					LastAddress: kv.Value,
+				})
+			}
 		}
 	}
 
+	if foundKeys != 3 {
+		return AddressRange{}, false, nil
+	}
+
 	return addrRange, true, nil
 }
 
@@ -117,11 +461,51 @@ func (conn *EtcdConnection) GetAddrRange(prefix string) (AddressRange, bool, err
 	return conn.getAddrRangeWithRetries(prefix, conn.Retries)
 }
 
+func (conn *EtcdConnection) listAddrRangesWithRetries(rootPrefix string, retries int) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	getRes, err := conn.Client.Get(ctx, rootPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return []string{}, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.listAddrRangesWithRetries(rootPrefix, retries - 1)
+	}
+
+	prefixes := []string{}
+	for _, kv := range getRes.Kvs {
+		prefix, isTypeKey := strings.CutSuffix(string(kv.Key), "info/type")
+		if isTypeKey {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes, nil
+}
+
+/*
+	Returns the key prefixes of all the ranges under the root prefix, found by their info/type key.
+	Note that a user defined name ending with info/type would also match, so prefixes should be validated with GetAddrRange.
+*/
+func (conn *EtcdConnection) ListAddrRanges(rootPrefix string) ([]string, error) {
+	return conn.listAddrRangesWithRetries(rootPrefix, conn.Retries)
+}
+
 func (conn *EtcdConnection) destroyAddrRangeWithRetries(prefix string, retries int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
 	defer cancel()
 
-	_, err := conn.Client.Delete(ctx, prefix, clientv3.WithPrefix())
+	registryOps, registryErr := conn.getRegistryRemovalOpsWithRetries(prefix, retries)
+	if registryErr != nil {
+		return registryErr
+	}
+
+	_, err := conn.Client.Txn(ctx).Then(
+		slices.Concat([]clientv3.Op{clientv3.OpDelete(prefix, clientv3.WithPrefix())}, registryOps)...
+	).Commit()
 	if err != nil {
 		if !shouldRetry(err, retries) {
 			return err
@@ -138,6 +522,87 @@ func (conn *EtcdConnection) DestroyAddrRange(prefix string) error {
 	return conn.destroyAddrRangeWithRetries(prefix, conn.Retries)
 }
 
+/*
+	check during transaction:
+	  - name/ is empty
+	transaction:
+	  - delete all the keys under the range prefix
+	  - remove the range from the registry
+	if the check fails, the names still in name/ are returned in the error
+*/
+func (conn *EtcdConnection) destroyEmptyAddrRangeWithRetries(prefix string, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	registryOps, registryErr := conn.getRegistryRemovalOpsWithRetries(prefix, retries)
+	if registryErr != nil {
+		return registryErr
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		clientv3.Compare(clientv3.CreateRevision(addrKeyPrefixes.Name), "=", 0).WithPrefix(),
+	).Then(
+		slices.Concat([]clientv3.Op{clientv3.OpDelete(prefix, clientv3.WithPrefix())}, registryOps)...
+	).Else(
+		clientv3.OpGet(addrKeyPrefixes.Name, clientv3.WithPrefix(), clientv3.WithKeysOnly(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend)),
+	)
+
+	resp, err := tx.Commit()
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.destroyEmptyAddrRangeWithRetries(prefix, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		names := []string{}
+		for _, kv := range resp.Responses[0].GetResponseRange().Kvs {
+			names = append(names, strings.TrimPrefix(string(kv.Key), addrKeyPrefixes.Name))
+		}
+
+		return errors.New(fmt.Sprintf("Failed to destroy address range at prefix '%s': Range still has the following names assigned: %s", prefix, strings.Join(names, ", ")))
+	}
+
+	return nil
+}
+
+//Like DestroyAddrRange, but fails if the range still has assigned addresses
+func (conn *EtcdConnection) DestroyEmptyAddrRange(prefix string) error {
+	return conn.destroyEmptyAddrRangeWithRetries(prefix, conn.Retries)
+}
+
+/*
+	Counts the addresses in generated/ and hardcoded/, so that each address of a block is counted, along with those in
+	quarantine/ as they can't be assigned until their reuse delay elapses
+*/
+func (conn *EtcdConnection) getUsedAddressCountWithRetries(prefix string, retries int) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	getRes, err := conn.Client.Txn(ctx).Then(
+		clientv3.OpGet(addrKeyPrefixes.GeneratedAddress, clientv3.WithPrefix(), clientv3.WithCountOnly()),
+		clientv3.OpGet(addrKeyPrefixes.HardcodedAddress, clientv3.WithPrefix(), clientv3.WithCountOnly()),
+		clientv3.OpGet(addrKeyPrefixes.QuarantinedAddress, clientv3.WithPrefix(), clientv3.WithCountOnly()),
+	).Commit()
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return 0, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getUsedAddressCountWithRetries(prefix, retries - 1)
+	}
+
+	return getRes.Responses[0].GetResponseRange().Count + getRes.Responses[1].GetResponseRange().Count + getRes.Responses[2].GetResponseRange().Count, nil
+}
+
 func (conn *EtcdConnection) GetAddrRangeUsage(prefix string, rangeAddrCount RangeAddressCount) (AddrRangeUsage, error) {
 	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(prefix)
 	if !addrRangeExists {
@@ -149,14 +614,34 @@ func (conn *EtcdConnection) GetAddrRangeUsage(prefix string, rangeAddrCount Rang
 
 	capacity := rangeAddrCount(addrRange.FirstAddress, addrRange.LastAddress)
 	
-	addrList, addrListErr := conn.GetAddressList(prefix)
-	if addrListErr != nil {
-		return AddrRangeUsage{}, addrListErr
+	usedCapacity, usedCapacityErr := conn.getUsedAddressCountWithRetries(prefix, conn.Retries)
+	if usedCapacityErr != nil {
+		return AddrRangeUsage{}, usedCapacityErr
+	}
+
+	excludedCapacity := int64(0)
+	exactFreeCapacity := AddressRangeSize(addrRange.FirstAddress, addrRange.LastAddress)
+	for _, exclusion := range addrRange.Exclusions {
+		excludedCapacity = saturatingAdd(excludedCapacity, rangeAddrCount(exclusion.FirstAddress, exclusion.LastAddress))
+		exactFreeCapacity.Sub(exactFreeCapacity, AddressRangeSize(exclusion.FirstAddress, exclusion.LastAddress))
+	}
+	exactFreeCapacity.Sub(exactFreeCapacity, big.NewInt(usedCapacity))
+	if exactFreeCapacity.Sign() < 0 {
+		exactFreeCapacity.SetInt64(0)
+	}
+
+	//Counts of large ranges saturate, so the free capacity can't be derived exactly from them
+	freeCapacity := capacity - excludedCapacity - usedCapacity
+	if freeCapacity < 0 {
+		freeCapacity = 0
 	}
 
 	return AddrRangeUsage{
 		Capacity: capacity,
-		UsedCapacity: int64(len(addrList)),
-		FreeCapacity: capacity - int64(len(addrList)),
+		UsedCapacity: usedCapacity,
+		ExcludedCapacity: excludedCapacity,
+		FreeCapacity: freeCapacity,
+		ExactCapacity: AddressRangeSize(addrRange.FirstAddress, addrRange.LastAddress),
+		ExactFreeCapacity: exactFreeCapacity,
 	}, nil
 }
\ No newline at end of file
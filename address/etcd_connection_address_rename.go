@@ -0,0 +1,148 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+	Renames an assigned address without freeing it. The labels of the address follow it to the new name.
+
+	check before transaction:
+	  - old name has the address in name/
+	  - old name is not the name of a block in block/
+	  - new name is absent from name/ for all relevant prefixes
+	check during transaction:
+	  - old name still has the address in name/
+	  - address is still assigned to the old name in generated/ or hardcoded/
+	  - labels of the old name are unchanged
+	  - new name is absent from name/ for all relevant prefixes
+	transaction:
+	  - move the name from name/<old name> to name/<new name>
+	  - set the name of the address in generated/ or hardcoded/ to the new name
+	  - move the labels from labels/<old name> to labels/<new name>
+*/
+func (conn *EtcdConnection) renameAddressWithRetries(prefix string, mutExclPrefixes []string, oldName string, newName string, address []byte, prettify PrettifyAddr, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	exists, isHardcoded, existingAddr, detailsErr := conn.getAddressDetailsWithRetries(prefix, oldName, 0)
+	if detailsErr != nil {
+		if !shouldRetry(detailsErr, retries) {
+			return detailsErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, retries - 1)
+	}
+
+	if !exists {
+		return errors.New(fmt.Sprintf("Error renaming address '%s' in range at prefix '%s': Address was not found in range", oldName, prefix))
+	}
+
+	if !bytes.Equal(existingAddr, address) {
+		return errors.New(fmt.Sprintf("Error renaming address '%s' in range at prefix '%s': Address '%s' didn't match the expected address '%s'", oldName, prefix, prettify(existingAddr), prettify(address)))
+	}
+
+	for _, mutExclPrefix := range mutExclPrefixes {
+		_, newNameExists, newNameErr := conn.findAddressWithRetries(mutExclPrefix, newName, 0)
+		if newNameErr != nil {
+			if !shouldRetry(newNameErr, retries) {
+				return newNameErr
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, retries - 1)
+		}
+
+		if newNameExists {
+			return errors.New(fmt.Sprintf("Error renaming address '%s' to '%s': Name is already assigned in range at prefix '%s'", oldName, newName, mutExclPrefix))
+		}
+	}
+
+	getRes, getErr := conn.Client.Txn(ctx).Then(
+		clientv3.OpGet(addrKeyPrefixes.Block + oldName),
+		clientv3.OpGet(addrKeyPrefixes.Labels + oldName),
+	).Commit()
+	if getErr != nil {
+		if !shouldRetry(getErr, retries) {
+			return getErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, retries - 1)
+	}
+
+	if len(getRes.Responses[0].GetResponseRange().Kvs) > 0 {
+		return errors.New(fmt.Sprintf("Error renaming address '%s' in range at prefix '%s': Name is assigned a block of addresses", oldName, prefix))
+	}
+
+	addrKey := addrKeyPrefixes.GeneratedAddress + string(address)
+	if isHardcoded {
+		addrKey = addrKeyPrefixes.HardcodedAddress + string(address)
+	}
+
+	conds := []clientv3.Cmp{
+		clientv3.Compare(clientv3.Value(addrKeyPrefixes.Name + oldName), "=", string(address)),
+		clientv3.Compare(clientv3.Value(addrKey), "=", oldName),
+	}
+	for _, mutExclPrefix := range mutExclPrefixes {
+		addrKeyMutExclPrefixes := GenerateAddrEtcdKeyPrefixes(mutExclPrefix)
+		conds = append(conds, clientv3.Compare(clientv3.Version(addrKeyMutExclPrefixes.Name + newName), "=", 0))
+	}
+
+	ops := []clientv3.Op{
+		clientv3.OpDelete(addrKeyPrefixes.Name + oldName),
+		clientv3.OpPut(addrKeyPrefixes.Name + newName, string(address)),
+		clientv3.OpPut(addrKey, newName),
+	}
+
+	labelsKvs := getRes.Responses[1].GetResponseRange().Kvs
+	if len(labelsKvs) > 0 {
+		conds = append(conds, clientv3.Compare(clientv3.ModRevision(addrKeyPrefixes.Labels + oldName), "=", labelsKvs[0].ModRevision))
+		ops = append(
+			ops,
+			clientv3.OpDelete(addrKeyPrefixes.Labels + oldName),
+			clientv3.OpPut(addrKeyPrefixes.Labels + newName, string(labelsKvs[0].Value)),
+		)
+	} else {
+		conds = append(conds, clientv3.Compare(clientv3.Version(addrKeyPrefixes.Labels + oldName), "=", 0))
+		ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.Labels + newName))
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(conds...).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New(fmt.Sprintf("Failed to rename address '%s' to '%s': Address or names were modified concurrently", oldName, newName))
+		}
+
+		return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, retries - 1)
+	}
+
+	return nil
+}
+
+//The new name must not be assigned in any of the mutually exclusive prefixes, which should include the prefix of the address
+func (conn *EtcdConnection) RenameAddress(prefix string, mutExclPrefixes []string, oldName string, newName string, address []byte, prettify PrettifyAddr) error {
+	if oldName == newName {
+		return nil
+	}
+
+	return conn.renameAddressWithRetries(prefix, mutExclPrefixes, oldName, newName, address, prettify, conn.Retries)
+}
@@ -0,0 +1,138 @@
+package address
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"slices"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	AllocationStrategyAttribute = "allocation_strategy"
+	AllocationStrategySequential = "sequential"
+	AllocationStrategyRandom = "random"
+	AllocationStrategyHashOfName = "hash_of_name"
+)
+
+var AllocationStrategies = []string{
+	AllocationStrategySequential,
+	AllocationStrategyRandom,
+	AllocationStrategyHashOfName,
+}
+
+//Ranges created before allocation strategies were introduced do not have the attribute and are sequential
+func GetAllocationStrategy(addrRange AddressRange) string {
+	strategy, ok := addrRange.Attributes[AllocationStrategyAttribute]
+	if !ok || strategy == "" {
+		return AllocationStrategySequential
+	}
+
+	return strategy
+}
+
+func getAllocationStartOffset(strategy string, name string, size *big.Int) (*big.Int, error) {
+	if strategy == AllocationStrategyHashOfName {
+		hash := sha256.Sum256([]byte(name))
+		return new(big.Int).Mod(new(big.Int).SetBytes(hash[:]), size), nil
+	}
+
+	return rand.Int(rand.Reader, size)
+}
+
+/*
+	Free addresses in ranges with a random or hash_of_name allocation strategy are those outside of excluded sub-ranges and
+	absent from generated/, hardcoded/ and quarantine/ (an address in deleted/ is free).
+
+	read all of data/ in a single request
+	probe addresses in memory (see pickSpreadAddress)
+*/
+func (conn *EtcdConnection) findSpreadFreeAddressWithRetries(prefix string, addrRange AddressRange, name string, retries int) ([]byte, bool, error) {
+	snapshot, snapshotErr := conn.getBatchAllocationSnapshot(prefix)
+	if snapshotErr != nil {
+		if !shouldRetry(snapshotErr, retries) {
+			return []byte{}, false, snapshotErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.findSpreadFreeAddressWithRetries(prefix, addrRange, name, retries - 1)
+	}
+
+	return snapshot.pickSpreadAddress(addrRange, name)
+}
+
+/*
+	Used for ranges with a random or hash_of_name allocation strategy.
+	Next address is never moved for those ranges.
+
+	find a free address (see findSpreadFreeAddressWithRetries)
+	check during transaction:
+	  - picked address is absent from generated/
+	  - picked address is absent from hardcoded/
+	  - picked address is absent from quarantine/
+	  - name is absent from name/ for all relevant prefixes
+	transaction:
+	  - remove picked address from deleted/ if present
+	  - add picked address to generated/
+	  - add name to name/
+*/
+func (conn *EtcdConnection) createSpreadGeneratedAddressWithRetries(prefix string, addrRange AddressRange, mutExclPrefixes []string, name string, retries int) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	nameNoPresent := []clientv3.Cmp{}
+	for _, mutExclPrefix := range mutExclPrefixes{
+		addrKeyMutExclPrefixes := GenerateAddrEtcdKeyPrefixes(mutExclPrefix)
+		nameNoPresent = append(nameNoPresent, clientv3.Compare(clientv3.Version(addrKeyMutExclPrefixes.Name + name), "=", 0))
+	}
+
+	pickedAddr, full, findErr := conn.findSpreadFreeAddressWithRetries(prefix, addrRange, name, retries)
+	if findErr != nil {
+		return []byte{}, false, findErr
+	}
+
+	if full {
+		return []byte{}, true, nil
+	}
+
+	tx := conn.Client.Txn(ctx).If(
+		slices.Concat(
+			[]clientv3.Cmp{
+				clientv3.Compare(clientv3.Version(addrKeyPrefixes.GeneratedAddress + string(pickedAddr)), "=", 0),
+				clientv3.Compare(clientv3.Version(addrKeyPrefixes.HardcodedAddress + string(pickedAddr)), "=", 0),
+				clientv3.Compare(clientv3.Version(addrKeyPrefixes.QuarantinedAddress + string(pickedAddr)), "=", 0),
+			},
+			nameNoPresent,
+		)...
+	).Then(
+		clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + string(pickedAddr)),
+		clientv3.OpPut(addrKeyPrefixes.GeneratedAddress + string(pickedAddr), name),
+		clientv3.OpPut(addrKeyPrefixes.Name + name, string(pickedAddr)),
+	)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return []byte{}, false, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createSpreadGeneratedAddressWithRetries(prefix, addrRange, mutExclPrefixes, name, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return []byte{}, false, errors.New("Failed to create generated address: Selected name has already been assigned")
+		}
+
+		return conn.createSpreadGeneratedAddressWithRetries(prefix, addrRange, mutExclPrefixes, name, retries - 1)
+	}
+
+	return pickedAddr, false, nil
+}
@@ -11,14 +11,17 @@ import (
 )
 
 type AddrRangeKeyspace struct {
-	Type               string
-	FirstAddress       []byte
-	LastAddress        []byte
-	NextAddress        []byte
-	Names              []AddressListEntry
-	GeneratedAddresses []AddressListEntry
-	HardcodedAddresses []AddressListEntry
-	DeletedAddresses   []AddressListEntry
+	Type                 string
+	FirstAddress         []byte
+	LastAddress          []byte
+	NextAddress          []byte
+	Names                []AddressListEntry
+	GeneratedAddresses   []AddressListEntry
+	HardcodedAddresses   []AddressListEntry
+	DeletedAddresses     []AddressListEntry
+	QuarantinedAddresses []QuarantineListEntry
+	Exclusions           []AddressExclusion
+	Labels               map[string]map[string]string
 }
 
 func (conn *EtcdConnection) getKeyspaceAddrListWithRetries(addrPrefix string, retries int) ([]AddressListEntry, error) {
@@ -84,6 +87,16 @@ func (conn *EtcdConnection) GetAddrRangeKeyspace(prefix string) (AddrRangeKeyspa
 		return AddrRangeKeyspace{}, deletedListErr
 	}
 
+	quarantinedList, quarantinedListErr := conn.GetQuarantineList(prefix)
+	if quarantinedListErr != nil {
+		return AddrRangeKeyspace{}, quarantinedListErr
+	}
+
+	labelsList, labelsListErr := conn.GetAddressLabelsList(prefix)
+	if labelsListErr != nil {
+		return AddrRangeKeyspace{}, labelsListErr
+	}
+
 	return AddrRangeKeyspace{
 		Type: addrRange.Type,
 		FirstAddress: addrRange.FirstAddress,
@@ -93,5 +106,8 @@ func (conn *EtcdConnection) GetAddrRangeKeyspace(prefix string) (AddrRangeKeyspa
 		GeneratedAddresses: generatedList,
 		HardcodedAddresses: hardcodedList,
 		DeletedAddresses: deletedList,
+		QuarantinedAddresses: quarantinedList,
+		Exclusions: addrRange.Exclusions,
+		Labels: labelsList,
 	}, nil
 }
\ No newline at end of file
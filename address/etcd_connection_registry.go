@@ -0,0 +1,146 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type RegisteredRange struct {
+	Prefix       string
+	Type         string
+	FirstAddress []byte
+	LastAddress  []byte
+}
+
+func (conn *EtcdConnection) registryRangesPrefix(rangeType string) string {
+	if rangeType == "" {
+		return conn.RegistryPrefix + "ranges/"
+	}
+
+	return conn.RegistryPrefix + "ranges/" + rangeType + "/"
+}
+
+func (conn *EtcdConnection) registryRangeKey(rangeType string, prefix string) string {
+	return conn.registryRangesPrefix(rangeType) + prefix
+}
+
+//The first and last address of a range always have the same length, so they are simply concatenated
+func encodeRegisteredRangeBoundaries(firstAddr []byte, lastAddr []byte) string {
+	return string(firstAddr) + string(lastAddr)
+}
+
+func decodeRegisteredRangeBoundaries(value []byte) ([]byte, []byte, error) {
+	if len(value) == 0 || len(value) % 2 != 0 {
+		return []byte{}, []byte{}, errors.New("Error decoding registered range boundaries: Invalid length")
+	}
+
+	return value[:len(value)/2], value[len(value)/2:], nil
+}
+
+//Boundaries are compared as integers as integer ranges of different bit widths have addresses of different lengths
+func rangesOverlap(firstAddr []byte, lastAddr []byte, otherFirstAddr []byte, otherLastAddr []byte) bool {
+	first := new(big.Int).SetBytes(firstAddr)
+	last := new(big.Int).SetBytes(lastAddr)
+	otherFirst := new(big.Int).SetBytes(otherFirstAddr)
+	otherLast := new(big.Int).SetBytes(otherLastAddr)
+	return first.Cmp(otherLast) <= 0 && otherFirst.Cmp(last) <= 0
+}
+
+func (conn *EtcdConnection) getRegisteredRangesWithRetries(rangeType string, retries int) ([]RegisteredRange, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	getRes, err := conn.Client.Get(ctx, conn.registryRangesPrefix(rangeType), clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		if !shouldRetry(err, retries) {
+			return []RegisteredRange{}, 0, err
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.getRegisteredRangesWithRetries(rangeType, retries - 1)
+	}
+
+	registeredRanges := make([]RegisteredRange, len(getRes.Kvs))
+	for idx, kv := range getRes.Kvs {
+		registeredType, prefix, _ := strings.Cut(strings.TrimPrefix(string(kv.Key), conn.registryRangesPrefix("")), "/")
+		firstAddr, lastAddr, decodeErr := decodeRegisteredRangeBoundaries(kv.Value)
+		if decodeErr != nil {
+			return []RegisteredRange{}, 0, decodeErr
+		}
+
+		registeredRanges[idx] = RegisteredRange{prefix, registeredType, firstAddr, lastAddr}
+	}
+
+	return registeredRanges, getRes.Header.Revision, nil
+}
+
+//Returns the ranges registered in the registry. All ranges are returned if the type is empty
+func (conn *EtcdConnection) GetRegisteredRanges(rangeType string) ([]RegisteredRange, error) {
+	if conn.RegistryPrefix == "" {
+		return []RegisteredRange{}, errors.New("Error retrieving registered ranges: No registry prefix was configured")
+	}
+
+	registeredRanges, _, err := conn.getRegisteredRangesWithRetries(rangeType, conn.Retries)
+	return registeredRanges, err
+}
+
+/*
+	Returns the conditions and operations to add to the transaction registering a range with the given boundaries:
+	check during transaction:
+	  - no range of the same type was registered or modified in the registry since the check for overlaps
+	transaction:
+	  - put the range boundaries in the registry
+	There are no conditions or operations if no registry prefix was configured
+*/
+func (conn *EtcdConnection) getRegistryTransactionWithRetries(prefix string, addrRange AddressRange, retries int) ([]clientv3.Cmp, []clientv3.Op, error) {
+	if conn.RegistryPrefix == "" {
+		return []clientv3.Cmp{}, []clientv3.Op{}, nil
+	}
+
+	registeredRanges, revision, err := conn.getRegisteredRangesWithRetries(addrRange.Type, retries)
+	if err != nil {
+		return []clientv3.Cmp{}, []clientv3.Op{}, err
+	}
+
+	for _, registeredRange := range registeredRanges {
+		if registeredRange.Prefix == prefix {
+			continue
+		}
+
+		if rangesOverlap(addrRange.FirstAddress, addrRange.LastAddress, registeredRange.FirstAddress, registeredRange.LastAddress) {
+			return []clientv3.Cmp{}, []clientv3.Op{}, errors.New(fmt.Sprintf("Range would overlap with the %s range at prefix '%s'", registeredRange.Type, registeredRange.Prefix))
+		}
+	}
+
+	return []clientv3.Cmp{
+		clientv3.Compare(clientv3.ModRevision(conn.registryRangesPrefix(addrRange.Type)), "<", revision + 1).WithPrefix(),
+	}, []clientv3.Op{
+		clientv3.OpPut(conn.registryRangeKey(addrRange.Type, prefix), encodeRegisteredRangeBoundaries(addrRange.FirstAddress, addrRange.LastAddress)),
+	}, nil
+}
+
+//Returns the operations to add to the transaction destroying a range to remove it from the registry
+func (conn *EtcdConnection) getRegistryRemovalOpsWithRetries(prefix string, retries int) ([]clientv3.Op, error) {
+	if conn.RegistryPrefix == "" {
+		return []clientv3.Op{}, nil
+	}
+
+	addrRange, addrRangeExists, err := conn.getAddrRangeWithRetries(prefix, retries)
+	if err != nil {
+		return []clientv3.Op{}, err
+	}
+
+	if !addrRangeExists {
+		return []clientv3.Op{}, nil
+	}
+
+	return []clientv3.Op{
+		clientv3.OpDelete(conn.registryRangeKey(addrRange.Type, prefix)),
+	}, nil
+}
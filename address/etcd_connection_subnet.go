@@ -0,0 +1,740 @@
+package address
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+/*
+  Subnets are allocated from a prefix range (whose boundaries are the parent cidr) with the same keyspace as addresses.
+  The keys under generated/, hardcoded/ and deleted/ (and the values under name/) are the subnet's network address followed by a byte holding its prefix length.
+  Because subnets of different sizes can overlap without sharing a key, subnet operations read the entire data/ keyspace of the range
+  and their transaction fails if any key under data/ was modified since that read.
+*/
+
+type subnetEntry struct {
+	First  uint64
+	Length int
+	Name   string
+}
+
+func (entry subnetEntry) Last() uint64 {
+	return entry.First + subnetSize(entry.Length) - 1
+}
+
+func (entry subnetEntry) Contains(other subnetEntry) bool {
+	return entry.First <= other.First && other.Last() <= entry.Last()
+}
+
+func (entry subnetEntry) Overlaps(first uint64, last uint64) bool {
+	return entry.First <= last && first <= entry.Last()
+}
+
+func (entry subnetEntry) Key() string {
+	return string(encodeIpv4Subnet(entry.First, entry.Length))
+}
+
+type subnetPool struct {
+	Revision     int64
+	FirstAddress uint64
+	LastAddress  uint64
+	NextAddress  uint64
+	Generated    []subnetEntry
+	Hardcoded    []subnetEntry
+	Deleted      []subnetEntry
+}
+
+func subnetSize(length int) uint64 {
+	return uint64(1) << (32 - length)
+}
+
+func alignSubnetAddress(addr uint64, length int) uint64 {
+	size := subnetSize(length)
+	return ((addr + size - 1) / size) * size
+}
+
+func ipv4ToUint64(addr []byte) (uint64, bool) {
+	addr4 := net.IP(addr).To4()
+	if addr4 == nil {
+		return 0, false
+	}
+
+	return uint64(binary.BigEndian.Uint32(addr4)), true
+}
+
+func uint64ToIpv4(addr uint64) []byte {
+	addr4 := make(net.IP, 4)
+	binary.BigEndian.PutUint32(addr4, uint32(addr))
+	return []byte(addr4.To16())
+}
+
+func encodeIpv4Subnet(first uint64, length int) []byte {
+	return append(uint64ToIpv4(first), byte(length))
+}
+
+func decodeIpv4Subnet(subnet []byte, name string) (subnetEntry, error) {
+	if len(subnet) < 2 {
+		return subnetEntry{}, errors.New("Subnet entry is too short")
+	}
+
+	first, ok := ipv4ToUint64(subnet[:len(subnet)-1])
+	if !ok {
+		return subnetEntry{}, errors.New("Subnet entry does not have an ipv4 network address")
+	}
+
+	return subnetEntry{First: first, Length: int(subnet[len(subnet)-1]), Name: name}, nil
+}
+
+//The next address pointer moves past the last address of the range when the pool is exhausted, which can't be represented as ipv4 for the last ipv4 address
+func encodeSubnetPoolPointer(addr uint64, pool subnetPool) []byte {
+	if addr > uint64(^uint32(0)) {
+		return IncAddressBy1(uint64ToIpv4(pool.LastAddress))
+	}
+
+	return uint64ToIpv4(addr)
+}
+
+//Splits a subnet around one of its sub-subnets, returning the largest subnets covering the rest of its space
+func splitSubnet(parent subnetEntry, child subnetEntry) []subnetEntry {
+	remainders := []subnetEntry{}
+	current := parent
+	for current.Length < child.Length {
+		lower := subnetEntry{First: current.First, Length: current.Length + 1}
+		upper := subnetEntry{First: current.First + subnetSize(current.Length + 1), Length: current.Length + 1}
+		if lower.Contains(child) {
+			remainders = append(remainders, upper)
+			current = lower
+		} else {
+			remainders = append(remainders, lower)
+			current = upper
+		}
+	}
+
+	return remainders
+}
+
+//Returns the largest aligned subnets covering the addresses between first and last inclusively, skipping hardcoded subnets
+func coverAddressesWithSubnets(first uint64, last uint64, hardcoded []subnetEntry) []subnetEntry {
+	subnets := []subnetEntry{}
+	addr := first
+	for addr <= last {
+		skipped := false
+		for _, entry := range hardcoded {
+			if entry.Overlaps(addr, addr) {
+				addr = entry.Last() + 1
+				skipped = true
+				break
+			}
+		}
+		if skipped {
+			continue
+		}
+
+		length := 32
+		for length > 0 {
+			candidate := subnetEntry{First: addr, Length: length - 1}
+			if addr % subnetSize(length - 1) != 0 || candidate.Last() > last {
+				break
+			}
+
+			overlapsHardcoded := false
+			for _, entry := range hardcoded {
+				if entry.Overlaps(candidate.First, candidate.Last()) {
+					overlapsHardcoded = true
+					break
+				}
+			}
+			if overlapsHardcoded {
+				break
+			}
+
+			length -= 1
+		}
+
+		subnets = append(subnets, subnetEntry{First: addr, Length: length})
+		addr = addr + subnetSize(length)
+	}
+
+	return subnets
+}
+
+func (conn *EtcdConnection) getSubnetPool(prefix string) (subnetPool, bool, error) {
+	addrRange, addrRangeExists, addrRangeErr := conn.getAddrRangeWithRetries(prefix, 0)
+	if addrRangeErr != nil || !addrRangeExists {
+		return subnetPool{}, addrRangeExists, addrRangeErr
+	}
+
+	pool := subnetPool{}
+	pool.FirstAddress, _ = ipv4ToUint64(addrRange.FirstAddress)
+	pool.LastAddress, _ = ipv4ToUint64(addrRange.LastAddress)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	getRes, err := conn.Client.Get(ctx, prefix + "data/", clientv3.WithPrefix())
+	if err != nil {
+		return subnetPool{}, true, err
+	}
+	pool.Revision = getRes.Header.Revision
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+	nextAddressFound := false
+	for _, kv := range getRes.Kvs {
+		key := string(kv.Key)
+		if key == addrRangeKeys.NextAddress {
+			nextAddr, isIpv4 := ipv4ToUint64(kv.Value)
+			if !isIpv4 {
+				nextAddr = pool.LastAddress + 1
+			}
+			pool.NextAddress = nextAddr
+			nextAddressFound = true
+			continue
+		}
+
+		for _, keyspace := range []struct{
+			keyPrefix string
+			entries   *[]subnetEntry
+		}{
+			{addrKeyPrefixes.GeneratedAddress, &pool.Generated},
+			{addrKeyPrefixes.HardcodedAddress, &pool.Hardcoded},
+			{addrKeyPrefixes.DeletedAddress, &pool.Deleted},
+		} {
+			subnet, isInKeyspace := bytes.CutPrefix(kv.Key, []byte(keyspace.keyPrefix))
+			if !isInKeyspace {
+				continue
+			}
+
+			entry, entryErr := decodeIpv4Subnet(subnet, string(kv.Value))
+			if entryErr != nil {
+				return subnetPool{}, true, errors.New(fmt.Sprintf("Error reading subnet pool at prefix '%s': %s", prefix, entryErr.Error()))
+			}
+			*keyspace.entries = append(*keyspace.entries, entry)
+		}
+	}
+
+	if !nextAddressFound {
+		return subnetPool{}, true, errors.New(fmt.Sprintf("Error accessing next address for range with prefix '%s': Key not found", prefix))
+	}
+
+	return pool, true, nil
+}
+
+func (pool subnetPool) unchangedCmp(prefix string) clientv3.Cmp {
+	return clientv3.Compare(clientv3.ModRevision(prefix + "data/"), "<", pool.Revision + 1).WithPrefix()
+}
+
+/*
+	if deleted/ has a subnet at least as large as the requested size:
+	  pick the smallest one
+	  transaction:
+	    - Remove picked subnet from deleted/
+		- Add the first sub-subnet of the requested size to generated/
+		- Add the remainder of the picked subnet to deleted/
+		- Add name to name/
+	if deleted/ has no such subnet:
+	  align the next assignable address on the requested size, skipping over hardcoded subnets
+	  transaction:
+	    - Add the aligned subnet to generated/
+		- Add any space skipped over for alignment to deleted/
+		- set next assignable address to the address following the aligned subnet
+		- Add name to name/
+	all transactions also check that:
+	  - no key was modified under data/ since the subnet pool was read
+	  - name is absent from name/
+*/
+func (conn *EtcdConnection) createGeneratedSubnetWithRetries(prefix string, name string, prefixLength int, retries int) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	pool, poolExists, poolErr := conn.getSubnetPool(prefix)
+	if poolErr != nil {
+		if !shouldRetry(poolErr, retries) {
+			return []byte{}, false, poolErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedSubnetWithRetries(prefix, name, prefixLength, retries - 1)
+	}
+	if !poolExists {
+		return []byte{}, false, errors.New("Error creating generated subnet: Range does not exist")
+	}
+
+	ops := []clientv3.Op{}
+	allocated := subnetEntry{Length: prefixLength, Name: name}
+
+	sort.SliceStable(pool.Deleted, func(i, j int) bool {
+		if pool.Deleted[i].Length != pool.Deleted[j].Length {
+			return pool.Deleted[i].Length > pool.Deleted[j].Length
+		}
+		return pool.Deleted[i].First < pool.Deleted[j].First
+	})
+
+	deletedFound := false
+	for _, deleted := range pool.Deleted {
+		if deleted.Length > prefixLength {
+			continue
+		}
+
+		allocated.First = deleted.First
+		ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + deleted.Key()))
+		for _, remainder := range splitSubnet(deleted, allocated) {
+			ops = append(ops, clientv3.OpPut(addrKeyPrefixes.DeletedAddress + remainder.Key(), ""))
+		}
+		deletedFound = true
+		break
+	}
+
+	if !deletedFound {
+		candidate := alignSubnetAddress(pool.NextAddress, prefixLength)
+		for {
+			allocated.First = candidate
+			if allocated.Last() > pool.LastAddress {
+				//Range is full
+				return []byte{}, true, nil
+			}
+
+			overlapped := false
+			for _, hardcoded := range pool.Hardcoded {
+				if hardcoded.Overlaps(allocated.First, allocated.Last()) {
+					candidate = alignSubnetAddress(hardcoded.Last() + 1, prefixLength)
+					overlapped = true
+					break
+				}
+			}
+
+			if !overlapped {
+				break
+			}
+		}
+
+		if pool.NextAddress < allocated.First {
+			for _, skipped := range coverAddressesWithSubnets(pool.NextAddress, allocated.First - 1, pool.Hardcoded) {
+				ops = append(ops, clientv3.OpPut(addrKeyPrefixes.DeletedAddress + skipped.Key(), ""))
+			}
+		}
+
+		ops = append(ops, clientv3.OpPut(addrRangeKeys.NextAddress, string(encodeSubnetPoolPointer(allocated.Last() + 1, pool))))
+	}
+
+	ops = append(
+		ops,
+		clientv3.OpPut(addrKeyPrefixes.GeneratedAddress + allocated.Key(), name),
+		clientv3.OpPut(addrKeyPrefixes.Name + name, allocated.Key()),
+	)
+
+	tx := conn.Client.Txn(ctx).If(
+		pool.unchangedCmp(prefix),
+		clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), "=", 0),
+	).Then(ops...)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return []byte{}, false, txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createGeneratedSubnetWithRetries(prefix, name, prefixLength, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return []byte{}, false, errors.New("Failed to create generated subnet: Either the selected name has already been assigned or the subnet pool kept being modified concurrently")
+		}
+
+		return conn.createGeneratedSubnetWithRetries(prefix, name, prefixLength, retries - 1)
+	}
+
+	return []byte(allocated.Key()), false, nil
+}
+
+/*
+  check before transaction:
+    - subnet is within the range
+	- subnet does not overlap with any subnet in generated/ or hardcoded/
+  transaction:
+    - Insert subnet in hardcoded/
+	- Insert name in names/
+	- Remove subnets in deleted/ that overlap with the subnet, adding back any space they have outside the subnet
+	- If the subnet covers the next assignable address, set the next assignable address to the address following the subnet
+  check during transaction:
+    - no key was modified under data/ since the subnet pool was read
+	- name is absent from name/
+*/
+func (conn *EtcdConnection) createHardcodedSubnetWithRetries(prefix string, name string, subnet []byte, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+	addrRangeKeys := GenerateAddrRangeEtcdKeys(prefix)
+
+	hardcoded, hardcodedErr := decodeIpv4Subnet(subnet, name)
+	if hardcodedErr != nil {
+		return errors.New(fmt.Sprintf("Error creating hardcoded subnet: %s", hardcodedErr.Error()))
+	}
+
+	pool, poolExists, poolErr := conn.getSubnetPool(prefix)
+	if poolErr != nil {
+		if !shouldRetry(poolErr, retries) {
+			return poolErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createHardcodedSubnetWithRetries(prefix, name, subnet, retries - 1)
+	}
+	if !poolExists {
+		return errors.New(fmt.Sprintf("Error creating hardcoded subnet '%s': Range not found", Ipv4CidrBytesToString(subnet)))
+	}
+
+	if hardcoded.First < pool.FirstAddress || hardcoded.Last() > pool.LastAddress {
+		return errors.New(fmt.Sprintf("Error creating hardcoded subnet '%s': Subnet is outside of range boundaries", Ipv4CidrBytesToString(subnet)))
+	}
+
+	for _, assigned := range append(pool.Generated, pool.Hardcoded...) {
+		if assigned.Overlaps(hardcoded.First, hardcoded.Last()) {
+			return errors.New(fmt.Sprintf("Error creating hardcoded subnet '%s': Subnet overlaps with subnet '%s' assigned to '%s'", Ipv4CidrBytesToString(subnet), Ipv4CidrBytesToString([]byte(assigned.Key())), assigned.Name))
+		}
+	}
+
+	ops := []clientv3.Op{}
+	for _, deleted := range pool.Deleted {
+		if !deleted.Overlaps(hardcoded.First, hardcoded.Last()) {
+			continue
+		}
+
+		ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + deleted.Key()))
+		if deleted.Contains(hardcoded) {
+			for _, remainder := range splitSubnet(deleted, hardcoded) {
+				ops = append(ops, clientv3.OpPut(addrKeyPrefixes.DeletedAddress + remainder.Key(), ""))
+			}
+		}
+	}
+
+	if hardcoded.First < pool.NextAddress && pool.NextAddress <= hardcoded.Last() {
+		ops = append(ops, clientv3.OpPut(addrRangeKeys.NextAddress, string(encodeSubnetPoolPointer(hardcoded.Last() + 1, pool))))
+	}
+
+	ops = append(
+		ops,
+		clientv3.OpPut(addrKeyPrefixes.HardcodedAddress + hardcoded.Key(), name),
+		clientv3.OpPut(addrKeyPrefixes.Name + name, hardcoded.Key()),
+	)
+
+	tx := conn.Client.Txn(ctx).If(
+		pool.unchangedCmp(prefix),
+		clientv3.Compare(clientv3.Version(addrKeyPrefixes.Name + name), "=", 0),
+	).Then(ops...)
+
+	resp, txErr := tx.Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.createHardcodedSubnetWithRetries(prefix, name, subnet, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New(fmt.Sprintf("Failed to create hardcoded subnet '%s': Either the selected name has already been assigned or the subnet pool kept being modified concurrently", Ipv4CidrBytesToString(subnet)))
+		}
+
+		return conn.createHardcodedSubnetWithRetries(prefix, name, subnet, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) CreateHardcodedSubnet(prefix string, name string, subnet []byte) error {
+	return conn.createHardcodedSubnetWithRetries(prefix, name, subnet, conn.Retries)
+}
+
+func (conn *EtcdConnection) CreateGeneratedSubnet(prefix string, name string, prefixLength int) ([]byte, error) {
+	subnet, full, err := conn.createGeneratedSubnetWithRetries(prefix, name, prefixLength, conn.Retries)
+	if err != nil {
+		return subnet, err
+	}
+	if full {
+		return subnet, errors.New(fmt.Sprintf("Error creating generated subnet: Range ran out of subnets with a prefix length of %d", prefixLength))
+	}
+
+	return subnet, nil
+}
+
+//Merges a freed subnet with its buddy (the other half of their parent subnet) for as long as the buddy is in deleted/
+func mergeFreedSubnet(freed subnetEntry, deleted []subnetEntry) (subnetEntry, []subnetEntry) {
+	merged := subnetEntry{First: freed.First, Length: freed.Length}
+	buddies := []subnetEntry{}
+	for merged.Length > 0 {
+		buddyFirst := merged.First ^ subnetSize(merged.Length)
+		buddyFound := false
+		for _, entry := range deleted {
+			if entry.First == buddyFirst && entry.Length == merged.Length {
+				buddies = append(buddies, entry)
+				buddyFound = true
+				break
+			}
+		}
+
+		if !buddyFound {
+			break
+		}
+
+		merged = subnetEntry{First: merged.First &^ subnetSize(merged.Length), Length: merged.Length - 1}
+	}
+
+	return merged, buddies
+}
+
+/*
+  Subnets are freed with a buddy allocation scheme so that the pool doesn't fragment over allocations and deletions:
+  the freed subnet is merged with the free half of its parent subnet in deleted/, recursively.
+  A hardcoded subnet past the next assignable address is not added to deleted/ as the space there is already free.
+  check during transaction:
+    - no key was modified under data/ since the subnet pool was read
+  transaction:
+    - Remove subnet from generated/ or hardcoded/
+	- Remove name from name/
+	- Remove the merged buddy subnets from deleted/
+	- Add the merged subnet to deleted/
+*/
+func (conn *EtcdConnection) deleteSubnetWithRetries(prefix string, name string, subnet []byte, isHardcoded bool, retries int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conn.Timeout)*time.Second)
+	defer cancel()
+
+	addrKeyPrefixes := GenerateAddrEtcdKeyPrefixes(prefix)
+
+	freed, freedErr := decodeIpv4Subnet(subnet, name)
+	if freedErr != nil {
+		return errors.New(fmt.Sprintf("Error deleting subnet: %s", freedErr.Error()))
+	}
+
+	pool, poolExists, poolErr := conn.getSubnetPool(prefix)
+	if poolErr != nil {
+		if !shouldRetry(poolErr, retries) {
+			return poolErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteSubnetWithRetries(prefix, name, subnet, isHardcoded, retries - 1)
+	}
+	if !poolExists {
+		return errors.New(fmt.Sprintf("Error deleting subnet '%s': Range not found", Ipv4CidrBytesToString(subnet)))
+	}
+
+	assignedKeyPrefix := addrKeyPrefixes.GeneratedAddress
+	assignedSubnets := pool.Generated
+	if isHardcoded {
+		assignedKeyPrefix = addrKeyPrefixes.HardcodedAddress
+		assignedSubnets = pool.Hardcoded
+	}
+
+	assigned := false
+	for _, entry := range assignedSubnets {
+		if entry.Key() == freed.Key() && entry.Name == name {
+			assigned = true
+			break
+		}
+	}
+	if !assigned {
+		return errors.New(fmt.Sprintf("Failed to delete subnet '%s': Either subnet or name have not been assigned", Ipv4CidrBytesToString(subnet)))
+	}
+
+	ops := []clientv3.Op{
+		clientv3.OpDelete(assignedKeyPrefix + freed.Key()),
+		clientv3.OpDelete(addrKeyPrefixes.Name + name),
+	}
+
+	if !isHardcoded || freed.First < pool.NextAddress {
+		merged, buddies := mergeFreedSubnet(freed, pool.Deleted)
+		for _, buddy := range buddies {
+			ops = append(ops, clientv3.OpDelete(addrKeyPrefixes.DeletedAddress + buddy.Key()))
+		}
+
+		mergedName := name
+		if len(buddies) > 0 {
+			mergedName = ""
+		}
+		ops = append(ops, clientv3.OpPut(addrKeyPrefixes.DeletedAddress + merged.Key(), mergedName))
+	}
+
+	resp, txErr := conn.Client.Txn(ctx).If(pool.unchangedCmp(prefix)).Then(ops...).Commit()
+	if txErr != nil {
+		if !shouldRetry(txErr, retries) {
+			return txErr
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		return conn.deleteSubnetWithRetries(prefix, name, subnet, isHardcoded, retries - 1)
+	}
+
+	if !resp.Succeeded {
+		if retries <= 0 {
+			return errors.New(fmt.Sprintf("Failed to delete subnet '%s': The subnet pool kept being modified concurrently", Ipv4CidrBytesToString(subnet)))
+		}
+
+		return conn.deleteSubnetWithRetries(prefix, name, subnet, isHardcoded, retries - 1)
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) DeleteSubnet(prefix string, name string, subnet []byte, isHardcoded bool) error {
+	return conn.deleteSubnetWithRetries(prefix, name, subnet, isHardcoded, conn.Retries)
+}
+
+//Capacities are in number of addresses, the used capacity being the number of addresses in assigned subnets
+func (conn *EtcdConnection) GetSubnetRangeUsage(prefix string) (AddrRangeUsage, error) {
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(prefix)
+	if !addrRangeExists {
+		return AddrRangeUsage{}, errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': Prefix does not exist", prefix))
+	}
+	if addrRangeErr != nil {
+		return AddrRangeUsage{}, addrRangeErr
+	}
+
+	capacity := Ipv4RangeAddressCount(addrRange.FirstAddress, addrRange.LastAddress)
+
+	subnetList, subnetListErr := conn.GetAddressList(prefix)
+	if subnetListErr != nil {
+		return AddrRangeUsage{}, subnetListErr
+	}
+
+	usedCapacity := int64(0)
+	for _, subnet := range subnetList {
+		entry, entryErr := decodeIpv4Subnet(subnet.Address, subnet.Name)
+		if entryErr != nil {
+			return AddrRangeUsage{}, entryErr
+		}
+
+		usedCapacity += int64(subnetSize(entry.Length))
+	}
+
+	return AddrRangeUsage{
+		Capacity: capacity,
+		UsedCapacity: usedCapacity,
+		FreeCapacity: capacity - usedCapacity,
+		ExactCapacity: big.NewInt(capacity),
+		ExactFreeCapacity: big.NewInt(capacity - usedCapacity),
+	}, nil
+}
+
+func (conn *EtcdConnection) validateSubnetRange(prefix string, rangeType string, prefixLength int) error {
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(prefix)
+	if addrRangeErr != nil {
+		return addrRangeErr
+	}
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error creating subnet in range with prefix '%s': Range doesn't exist", prefix))
+	}
+	if addrRange.Type != rangeType {
+		return errors.New(fmt.Sprintf("Error creating subnet in range with prefix '%s': Range type doesn't match the created subnet type", prefix))
+	}
+
+	rangeCapacity := Ipv4RangeAddressCount(addrRange.FirstAddress, addrRange.LastAddress)
+	if prefixLength > 32 || subnetSize(prefixLength) > uint64(rangeCapacity) {
+		return errors.New(fmt.Sprintf("Error creating subnet in range with prefix '%s': A prefix length of %d doesn't fit in the range", prefix, prefixLength))
+	}
+
+	return nil
+}
+
+func (conn *EtcdConnection) GenerateGeneratedSubnetWithValidation(name string, prefix string, rangeType string, prefixLength int, toleratePresent bool) (bool, []byte, error) {
+	validationErr := conn.validateSubnetRange(prefix, rangeType, prefixLength)
+	if validationErr != nil {
+		return false, []byte{}, validationErr
+	}
+
+	subnetExists, subnetIsHardcoded, subnet, detailsErr := conn.GetAddressDetails(prefix, name)
+	if detailsErr != nil {
+		return false, []byte{}, detailsErr
+	}
+
+	if subnetExists {
+		if !toleratePresent {
+			return false, []byte{}, errors.New(fmt.Sprintf("Error creating subnet '%s': Subnet was already present in range with prefix '%s'", name, prefix))
+		}
+
+		if subnetIsHardcoded {
+			return false, []byte{}, errors.New(fmt.Sprintf("Error creating subnet in range with prefix '%s': An existing subnet with the same name didn't match the expected hardcoded setting", prefix))
+		}
+
+		if int(subnet[len(subnet)-1]) != prefixLength {
+			return false, []byte{}, errors.New(fmt.Sprintf("Error creating subnet in range with prefix '%s': An existing subnet with the same name didn't match the expected prefix length", prefix))
+		}
+
+		return subnetExists, subnet, nil
+	}
+
+	subnet, genErr := conn.CreateGeneratedSubnet(prefix, name, prefixLength)
+	return subnetExists, subnet, genErr
+}
+
+func (conn *EtcdConnection) GenerateHardcodedSubnetWithValidation(name string, prefix string, rangeType string, subnet []byte, toleratePresent bool) (bool, error) {
+	validationErr := conn.validateSubnetRange(prefix, rangeType, int(subnet[len(subnet)-1]))
+	if validationErr != nil {
+		return false, validationErr
+	}
+
+	subnetExists, subnetIsHardcoded, existingSubnet, detailsErr := conn.GetAddressDetails(prefix, name)
+	if detailsErr != nil {
+		return false, detailsErr
+	}
+
+	if subnetExists {
+		if !toleratePresent {
+			return false, errors.New(fmt.Sprintf("Error creating hardcoded subnet '%s': Subnet was already present in range with prefix '%s'", name, prefix))
+		}
+
+		if !subnetIsHardcoded {
+			return false, errors.New(fmt.Sprintf("Error creating hardcoded subnet in range with prefix '%s': An existing subnet with the same name didn't match the expected hardcoded setting", prefix))
+		}
+
+		if !bytes.Equal(subnet, existingSubnet) {
+			return false, errors.New(fmt.Sprintf("Error creating hardcoded subnet in range with prefix '%s': An existing subnet with the same name didn't match the expected subnet value", prefix))
+		}
+
+		return subnetExists, nil
+	}
+
+	return subnetExists, conn.CreateHardcodedSubnet(prefix, name, subnet)
+}
+
+func (conn *EtcdConnection) DeleteSubnetWithValidation(name string, prefix string, isHardcoded bool, subnet []byte, tolerateMissing bool) (bool, error) {
+	subnetExists, subnetIsHardcoded, existingSubnet, detailsErr := conn.GetAddressDetails(prefix, name)
+	if detailsErr != nil {
+		return false, detailsErr
+	}
+
+	if !subnetExists {
+		if !tolerateMissing {
+			return false, errors.New(fmt.Sprintf("Error deleting subnet '%s' in range at prefix '%s': Subnet was not found in range", name, prefix))
+		}
+
+		return subnetExists, nil
+	}
+
+	if subnetIsHardcoded != isHardcoded {
+		return false, errors.New(fmt.Sprintf("Error deleting subnet '%s' in range at prefix '%s': Subnet didn't match expected hardcoded setting", name, prefix))
+	}
+
+	if !bytes.Equal(subnet, existingSubnet) {
+		return false, errors.New(fmt.Sprintf("Error deleting subnet '%s' in range at prefix '%s': Subnet didn't have expected value", name, prefix))
+	}
+
+	return subnetExists, conn.DeleteSubnet(prefix, name, subnet, isHardcoded)
+}
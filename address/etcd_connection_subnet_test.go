@@ -0,0 +1,38 @@
+package address
+
+import (
+	"testing"
+)
+
+func TestMergeFreedSubnet(t *testing.T) {
+	tests := []struct {
+		name string
+		freed [2]int
+		deleted [][2]int
+		expected [2]int
+		expectedBuddies int
+	}{
+		{"no free buddy", [2]int{0, 26}, [][2]int{{128, 26}}, [2]int{0, 26}, 0},
+		{"free buddy after", [2]int{0, 26}, [][2]int{{64, 26}}, [2]int{0, 25}, 1},
+		{"free buddy before", [2]int{64, 26}, [][2]int{{0, 26}}, [2]int{0, 25}, 1},
+		{"buddy of a different size", [2]int{0, 26}, [][2]int{{64, 27}}, [2]int{0, 26}, 0},
+		{"free buddies up the tree", [2]int{192, 26}, [][2]int{{0, 25}, {128, 26}}, [2]int{0, 24}, 2},
+		{"free buddy of the merged subnet only", [2]int{128, 26}, [][2]int{{0, 25}}, [2]int{128, 26}, 0},
+	}
+
+	for _, test := range tests {
+		deleted := []subnetEntry{}
+		for _, entry := range test.deleted {
+			deleted = append(deleted, subnetEntry{First: uint64(entry[0]), Length: entry[1]})
+		}
+
+		merged, buddies := mergeFreedSubnet(subnetEntry{First: uint64(test.freed[0]), Length: test.freed[1]}, deleted)
+		if merged.First != uint64(test.expected[0]) || merged.Length != test.expected[1] {
+			t.Errorf("Expected merged subnet for '%s' to start at %d with length %d and it started at %d with length %d", test.name, test.expected[0], test.expected[1], merged.First, merged.Length)
+		}
+
+		if len(buddies) != test.expectedBuddies {
+			t.Errorf("Expected %d buddies to be merged for '%s' and there were %d", test.expectedBuddies, test.name, len(buddies))
+		}
+	}
+}
@@ -0,0 +1,12 @@
+data "netaddr_range_ipv6" "test" {
+    key_prefix = "/test/ipv6/"
+}
+
+data "netaddr_address_ipv6" "test" {
+    range_id = data.netaddr_range_ipv6.test.id
+    name = "test"
+}
+
+output "data_ipv6_test" {
+  value = data.netaddr_address_ipv6.test.address
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+data "netaddr_range_ipv6" "range1" {
+    key_prefix = "/test/ipv6/"
+}
+
+data "netaddr_range_ipv6" "range2" {
+    key_prefix = "/test/ipv6-extras/"
+}
+
+data "netaddr_address_ipv6_v2" "test" {
+    range_ids = [data.netaddr_range_ipv6.range1.id, data.netaddr_range_ipv6.range2.id]
+    name = "test"
+}
+
+output "data_ipv6_test" {
+  value = data.netaddr_address_ipv6_v2.test.address
+}
\ No newline at end of file
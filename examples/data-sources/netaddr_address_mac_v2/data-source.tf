@@ -0,0 +1,16 @@
+data "netaddr_range_mac" "range1" {
+    key_prefix = "/test/mac/"
+}
+
+data "netaddr_range_mac" "range2" {
+    key_prefix = "/test/mac-extras/"
+}
+
+data "netaddr_address_mac_v2" "test" {
+    range_ids = [data.netaddr_range_mac.range1.id, data.netaddr_range_mac.range2.id]
+    name = "test"
+}
+
+output "data_mac_test" {
+  value = data.netaddr_address_mac_v2.test.address
+}
\ No newline at end of file
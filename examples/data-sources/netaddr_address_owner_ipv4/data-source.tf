@@ -0,0 +1,8 @@
+data "netaddr_address_owner_ipv4" "conflict" {
+    address = "192.168.0.10"
+    range_ids = ["/test/ipv4/", "/test/ipv4-2/"]
+}
+
+output "conflict_owner" {
+  value = "${data.netaddr_address_owner_ipv4.conflict.name} (hardcoded: ${data.netaddr_address_owner_ipv4.conflict.hardcoded}, range: ${data.netaddr_address_owner_ipv4.conflict.found_in_range})"
+}
\ No newline at end of file
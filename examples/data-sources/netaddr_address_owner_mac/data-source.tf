@@ -0,0 +1,8 @@
+data "netaddr_address_owner_mac" "conflict" {
+    address = "52:54:00:00:00:10"
+    range_ids = ["/test/mac/"]
+}
+
+output "conflict_owner" {
+  value = "${data.netaddr_address_owner_mac.conflict.name} (hardcoded: ${data.netaddr_address_owner_mac.conflict.hardcoded}, range: ${data.netaddr_address_owner_mac.conflict.found_in_range})"
+}
\ No newline at end of file
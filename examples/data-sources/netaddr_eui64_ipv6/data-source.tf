@@ -0,0 +1,9 @@
+data "netaddr_eui64_ipv6" "vm" {
+    prefix = "2001:db8:1:2::/64"
+    name = "vm-1"
+    range_id = "/test/mac/"
+}
+
+output "vm_slaac_address" {
+  value = data.netaddr_eui64_ipv6.vm.address
+}
\ No newline at end of file
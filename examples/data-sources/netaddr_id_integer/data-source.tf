@@ -0,0 +1,12 @@
+data "netaddr_range_integer" "vlans" {
+    key_prefix = "/test/vlans/"
+}
+
+data "netaddr_id_integer" "tenant" {
+    range_id = data.netaddr_range_integer.vlans.id
+    name = "tenant"
+}
+
+output "data_id_integer_tenant" {
+  value = data.netaddr_id_integer.tenant.value
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "netaddr_next_free_ipv4" "next" {
+    range_ids = ["/test/ipv4/"]
+    name = "my-next-address"
+}
+
+output "next_address" {
+  value = "${data.netaddr_next_free_ipv4.next.address} (range: ${data.netaddr_next_free_ipv4.next.found_in_range})"
+}
\ No newline at end of file
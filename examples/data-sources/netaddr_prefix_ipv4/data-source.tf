@@ -0,0 +1,7 @@
+data "netaddr_prefix_ipv4" "vpcs" {
+    key_prefix = "/test/prefix-ipv4/"
+}
+
+output "data_prefix_ipv4_vpcs" {
+  value = "cidr: ${data.netaddr_prefix_ipv4.vpcs.cidr}, first_address: ${data.netaddr_prefix_ipv4.vpcs.first_address}, last_address: ${data.netaddr_prefix_ipv4.vpcs.last_address}"
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "netaddr_range_integer" "vlans" {
+    key_prefix = "/test/vlans/"
+}
+
+output "data_range_integer_vlans" {
+  value = "bit_width: ${data.netaddr_range_integer.vlans.bit_width}, first_id: ${data.netaddr_range_integer.vlans.first_id}, last_id: ${data.netaddr_range_integer.vlans.last_id}"
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "netaddr_range_ipv6" "test" {
+    key_prefix = "/test/ipv6/"
+}
+
+output "data_range_ipv6_test" {
+  value = "first_address: ${data.netaddr_range_ipv6.test.first_address}, last_address: ${data.netaddr_range_ipv6.test.last_address}"
+}
\ No newline at end of file
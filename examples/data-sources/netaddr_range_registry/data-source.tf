@@ -0,0 +1,15 @@
+provider "netaddr" {
+  endpoints = "127.0.0.1:32379"
+  ca_cert = "${path.module}/certs/ca.pem"
+  cert = "${path.module}/certs/root.pem"
+  key = "${path.module}/certs/root.key"
+  registry_prefix = "/registry/"
+}
+
+data "netaddr_range_registry" "ipv4" {
+    type = "ipv4"
+}
+
+output "registered_ipv4_ranges" {
+  value = data.netaddr_range_registry.ipv4.ranges
+}
\ No newline at end of file
@@ -0,0 +1,22 @@
+data "netaddr_range_ipv6" "test" {
+    key_prefix = "/test/ipv6/"
+}
+
+data "netaddr_range_usage_ipv6" "test" {
+  range_id = data.netaddr_range_ipv6.test.id
+}
+
+output "range_capacity" {
+  description = "The range supports the following number of addresses (saturating at the largest signed 64 bits integer)."
+  value       = data.netaddr_range_usage_ipv6.test.capacity
+}
+
+output "range_used_capacity" {
+  description = "The range has allocated the following number of addresses."
+  value       = data.netaddr_range_usage_ipv6.test.used_capacity
+}
+
+output "range_free_capacity" {
+  description = "The range can allocate the following number of addresses before running out of ips."
+  value       = data.netaddr_range_usage_ipv6.test.free_capacity
+}
\ No newline at end of file
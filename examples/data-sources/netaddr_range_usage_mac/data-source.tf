@@ -0,0 +1,12 @@
+data "netaddr_range_mac" "test" {
+    key_prefix = "/test/mac/"
+}
+
+data "netaddr_range_usage_mac" "test" {
+  range_id = data.netaddr_range_mac.test.id
+}
+
+output "range_free_capacity" {
+  description = "The range can allocate the following number of addresses before running out of macs."
+  value       = data.netaddr_range_usage_mac.test.free_capacity
+}
\ No newline at end of file
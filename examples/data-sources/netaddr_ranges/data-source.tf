@@ -0,0 +1,8 @@
+data "netaddr_ranges" "ipv4" {
+    root_prefix = "/test/"
+    type = "ipv4"
+}
+
+output "ipv4_ranges" {
+  value = data.netaddr_ranges.ipv4.ranges
+}
\ No newline at end of file
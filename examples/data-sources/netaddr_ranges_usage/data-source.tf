@@ -0,0 +1,8 @@
+data "netaddr_ranges_usage" "test" {
+  range_ids = ["/test/ipv4/", "/test/ipv4-2/"]
+}
+
+output "ranges_free_capacity" {
+  description = "The ranges can allocate the following number of addresses before all of them run out of ips."
+  value       = data.netaddr_ranges_usage.test.free_capacity
+}
\ No newline at end of file
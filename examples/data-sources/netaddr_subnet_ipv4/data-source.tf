@@ -0,0 +1,12 @@
+data "netaddr_prefix_ipv4" "vpcs" {
+    key_prefix = "/test/prefix-ipv4/"
+}
+
+data "netaddr_subnet_ipv4" "vpc" {
+    range_id = data.netaddr_prefix_ipv4.vpcs.id
+    name = "vpc"
+}
+
+output "data_subnet_ipv4_vpc" {
+  value = data.netaddr_subnet_ipv4.vpc.subnet
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "netaddr_address_block_ipv4" "lb_vips" {
+    range_id = "/test/ipv4/"
+    name = "lb-vips"
+    size = 8
+    alignment = 8
+}
+
+output "lb_vips" {
+  value = "${netaddr_address_block_ipv4.lb_vips.first_address} - ${netaddr_address_block_ipv4.lb_vips.last_address}"
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+resource "netaddr_address_block_mac" "sriov_vfs" {
+    range_id = "/test/mac/"
+    name = "node-1-vfs"
+    size = 16
+}
+
+output "sriov_vfs" {
+  value = netaddr_address_block_mac.sriov_vfs.addresses
+}
\ No newline at end of file
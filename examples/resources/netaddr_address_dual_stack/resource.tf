@@ -0,0 +1,10 @@
+resource "netaddr_address_dual_stack" "node" {
+    name = "k8-node-1"
+    ipv4_range_id = "/test/ipv4/"
+    ipv6_range_id = "/test/ipv6/"
+    derive_ipv6_from_ipv4 = true
+}
+
+output "node_addresses" {
+  value = "${netaddr_address_dual_stack.node.ipv4_address} / ${netaddr_address_dual_stack.node.ipv6_address}"
+}
\ No newline at end of file
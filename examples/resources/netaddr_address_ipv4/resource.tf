@@ -13,6 +13,10 @@ resource "netaddr_address_ipv4" "test" {
 resource "netaddr_address_ipv4" "test2" {
     range_id = netaddr_range_ipv4.test.id
     name = "test2"
+    labels = {
+        owner = "platform"
+        hostname = "test2.example.com"
+    }
 }
 
 output "test_addr" {
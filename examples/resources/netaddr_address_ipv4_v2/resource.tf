@@ -30,7 +30,7 @@ output "test2_addr" {
 }
 
 //v1 to v2 Migration example
-//Note that you can also use the retain_on_delete / manage_existing notation to add ranges in-place to v2 addresses
+//Note that ranges can also be added in-place to the range_ids of v2 addresses
 
 resource "netaddr_range_ipv4" "original" {
     key_prefix = "/original/ipv4/"
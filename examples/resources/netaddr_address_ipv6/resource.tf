@@ -0,0 +1,24 @@
+resource "netaddr_range_ipv6" "test" {
+    key_prefix = "/test/ipv6/"
+    first_address = "fd00:10:128::1"
+    last_address = "fd00:10:128::ffff"
+}
+
+resource "netaddr_address_ipv6" "test" {
+    range_id = netaddr_range_ipv6.test.id
+    name = "test"
+    hardcoded_address = "fd00:10:128::5"
+}
+
+resource "netaddr_address_ipv6" "test2" {
+    range_id = netaddr_range_ipv6.test.id
+    name = "test2"
+}
+
+output "test_addr" {
+  value = netaddr_address_ipv6.test.address
+}
+
+output "test2_addr" {
+  value = netaddr_address_ipv6.test2.address
+}
\ No newline at end of file
@@ -0,0 +1,30 @@
+resource "netaddr_range_ipv6" "range1" {
+    key_prefix = "/test/ipv6/"
+    first_address = "fd00:10:128::1"
+    last_address = "fd00:10:128::ff"
+}
+
+resource "netaddr_range_ipv6" "range2" {
+    key_prefix = "/test/ipv6-extras/"
+    first_address = "fd00:10:128::1:0"
+    last_address = "fd00:10:128::1:ffff"
+}
+
+resource "netaddr_address_ipv6_v2" "test" {
+    range_ids = [netaddr_range_ipv6.range1.id, netaddr_range_ipv6.range2.id]
+    name = "test"
+    hardcoded_address = "fd00:10:128::5"
+}
+
+resource "netaddr_address_ipv6_v2" "test2" {
+    range_ids = [netaddr_range_ipv6.range1.id, netaddr_range_ipv6.range2.id]
+    name = "test2"
+}
+
+output "test_addr" {
+  value = netaddr_address_ipv6_v2.test.address
+}
+
+output "test2_addr" {
+  value = netaddr_address_ipv6_v2.test2.address
+}
\ No newline at end of file
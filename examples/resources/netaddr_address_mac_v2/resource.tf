@@ -0,0 +1,30 @@
+resource "netaddr_range_mac" "range1" {
+    key_prefix = "/test/mac/"
+    first_address = "52:54:00:00:00:00"
+    last_address = "52:54:00:00:ff:ff"
+}
+
+resource "netaddr_range_mac" "range2" {
+    key_prefix = "/test/mac-extras/"
+    first_address = "52:54:01:00:00:00"
+    last_address = "52:54:01:00:ff:ff"
+}
+
+resource "netaddr_address_mac_v2" "test" {
+    range_ids = [netaddr_range_mac.range1.id, netaddr_range_mac.range2.id]
+    name = "test"
+    hardcoded_address = "52:54:00:00:00:05"
+}
+
+resource "netaddr_address_mac_v2" "test2" {
+    range_ids = [netaddr_range_mac.range1.id, netaddr_range_mac.range2.id]
+    name = "test2"
+}
+
+output "test_addr" {
+  value = netaddr_address_mac_v2.test.address
+}
+
+output "test2_addr" {
+  value = netaddr_address_mac_v2.test2.address
+}
\ No newline at end of file
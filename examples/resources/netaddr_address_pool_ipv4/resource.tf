@@ -0,0 +1,14 @@
+resource "netaddr_address_pool_ipv4" "workers" {
+    range_id = "/test/ipv4/"
+    size = 40
+    name_template = "worker-%d"
+}
+
+resource "netaddr_address_pool_ipv4" "masters" {
+    range_id = "/test/ipv4/"
+    names = ["master-1", "master-2", "master-3"]
+}
+
+output "worker_addresses" {
+  value = netaddr_address_pool_ipv4.workers.addresses
+}
\ No newline at end of file
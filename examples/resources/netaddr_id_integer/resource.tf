@@ -0,0 +1,25 @@
+resource "netaddr_range_integer" "vlans" {
+    key_prefix = "/test/vlans/"
+    bit_width = 12
+    first_id = 100
+    last_id = 4094
+}
+
+resource "netaddr_id_integer" "management" {
+    range_id = netaddr_range_integer.vlans.id
+    name = "management"
+    hardcoded_value = 100
+}
+
+resource "netaddr_id_integer" "tenant" {
+    range_id = netaddr_range_integer.vlans.id
+    name = "tenant"
+}
+
+output "management_vlan" {
+  value = netaddr_id_integer.management.value
+}
+
+output "tenant_vlan" {
+  value = netaddr_id_integer.tenant.value
+}
\ No newline at end of file
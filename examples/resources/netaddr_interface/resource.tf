@@ -0,0 +1,9 @@
+resource "netaddr_interface" "vm" {
+    name = "vm-1"
+    ipv4_range_id = "/test/ipv4/"
+    mac_range_id = "/test/mac/"
+}
+
+output "vm_interface" {
+  value = "${netaddr_interface.vm.ipv4_address} / ${netaddr_interface.vm.mac_address}"
+}
\ No newline at end of file
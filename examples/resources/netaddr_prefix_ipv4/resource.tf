@@ -0,0 +1,4 @@
+resource "netaddr_prefix_ipv4" "vpcs" {
+    key_prefix = "/test/prefix-ipv4/"
+    cidr = "10.0.0.0/16"
+}
\ No newline at end of file
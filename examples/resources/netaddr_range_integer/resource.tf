@@ -0,0 +1,13 @@
+resource "netaddr_range_integer" "vlans" {
+    key_prefix = "/test/vlans/"
+    bit_width = 12
+    first_id = 100
+    last_id = 4094
+}
+
+resource "netaddr_range_integer" "private_asns" {
+    key_prefix = "/test/asns/"
+    bit_width = 32
+    first_id = 4200000000
+    last_id = 4294967294
+}
\ No newline at end of file
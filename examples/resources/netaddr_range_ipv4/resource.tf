@@ -2,4 +2,43 @@ resource "netaddr_range_ipv4" "test" {
     key_prefix = "/test/ipv4/"
     first_address = "192.168.0.1"
     last_address = "192.168.0.254"
+}
+
+resource "netaddr_range_ipv4" "quarantined" {
+    key_prefix = "/test/ipv4-quarantined/"
+    first_address = "192.168.1.1"
+    last_address = "192.168.1.254"
+    reuse_delay = 3600
+}
+
+resource "netaddr_range_ipv4" "with_exclusions" {
+    key_prefix = "/test/ipv4-exclusions/"
+    first_address = "192.168.2.1"
+    last_address = "192.168.2.254"
+
+    //Gateway
+    exclusions {
+        first_address = "192.168.2.1"
+        last_address = "192.168.2.1"
+    }
+
+    //Dhcp pool
+    exclusions {
+        first_address = "192.168.2.100"
+        last_address = "192.168.2.150"
+    }
+}
+
+resource "netaddr_range_ipv4" "from_cidr" {
+    key_prefix = "/test/ipv4-cidr/"
+    cidr = "192.168.3.0/24"
+    gateway = "192.168.3.1"
+    dns_servers = ["192.168.3.2", "192.168.3.3"]
+    vlan_id = 103
+
+    //Gateway and dns servers
+    exclusions {
+        first_address = "192.168.3.1"
+        last_address = "192.168.3.3"
+    }
 }
\ No newline at end of file
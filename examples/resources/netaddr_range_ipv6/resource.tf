@@ -0,0 +1,5 @@
+resource "netaddr_range_ipv6" "test" {
+    key_prefix = "/test/ipv6/"
+    first_address = "fd00:10:128::1"
+    last_address = "fd00:10:128::ffff"
+}
\ No newline at end of file
@@ -2,4 +2,23 @@ resource "netaddr_range_mac" "test" {
     key_prefix = "/test/mac/"
     first_address = "52:54:00:00:00:00"
     last_address = "52:54:00:ff:ff:ff"
+}
+
+resource "netaddr_range_mac" "hashed" {
+    key_prefix = "/test/mac-hashed/"
+    first_address = "52:54:01:00:00:00"
+    last_address = "52:54:01:ff:ff:ff"
+    allocation_strategy = "hash_of_name"
+}
+
+resource "netaddr_range_mac" "oui" {
+    key_prefix = "/test/mac-oui/"
+    oui = "52:54:02"
+}
+
+resource "netaddr_range_mac" "local" {
+    key_prefix = "/test/mac-local/"
+    first_address = "02:00:00:00:00:00"
+    last_address = "02:00:00:ff:ff:ff"
+    locally_administered_unicast = true
 }
\ No newline at end of file
@@ -0,0 +1,30 @@
+resource "netaddr_prefix_ipv4" "vpcs" {
+    key_prefix = "/test/prefix-ipv4/"
+    cidr = "10.0.0.0/16"
+}
+
+resource "netaddr_subnet_ipv4" "bootstrap" {
+    range_id = netaddr_prefix_ipv4.vpcs.id
+    name = "bootstrap"
+    hardcoded_subnet = "10.0.0.0/24"
+}
+
+resource "netaddr_subnet_ipv4" "vpc" {
+    range_id = netaddr_prefix_ipv4.vpcs.id
+    name = "vpc"
+    prefix_length = 24
+}
+
+resource "netaddr_subnet_ipv4" "pods" {
+    range_id = netaddr_prefix_ipv4.vpcs.id
+    name = "pods"
+    prefix_length = 28
+}
+
+output "vpc_subnet" {
+  value = netaddr_subnet_ipv4.vpc.subnet
+}
+
+output "pods_subnet" {
+  value = netaddr_subnet_ipv4.pods.subnet
+}
\ No newline at end of file
@@ -36,6 +36,14 @@ func dataSourceNetAddrAddressListIpv4() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+						"labels": {
+							Description:  "Labels stored alongside the name of the address",
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrAddressListIpv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves all ipv6 addresses in a range.",
+		Read: dataSourceNetAddrAddressListIpv6Read,
+		Schema: map[string]*schema.Schema{
+			"range_id": &schema.Schema{
+				Description: "Identifier of the address range to get the addresses from.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"addresses": {
+				Description: "List of addresses in the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"labels": {
+							Description:  "Labels stored alongside the name of the address",
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrAddressListIpv6Read(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrAddressListRead(d, meta, "ipv6", address.Ipv6BytesToString)
+}
\ No newline at end of file
@@ -30,6 +30,11 @@ func dataSourceNetAddrAddressListRead(d *schema.ResourceData, meta interface{},
 		return addrListErr
 	}
 
+	labelsList, labelsListErr := conn.GetAddressLabelsList(keyPrefix)
+	if labelsListErr != nil {
+		return labelsListErr
+	}
+
 	sort.SliceStable(addrList, func(i, j int) bool {
 		return addrList[i].Name < addrList[j].Name
 	})
@@ -39,6 +44,7 @@ func dataSourceNetAddrAddressListRead(d *schema.ResourceData, meta interface{},
 		schemaList = append(schemaList, map[string]interface{}{
 			"name": addr.Name,
 			"address": prettify(addr.Address),
+			"labels": labelsList[addr.Name],
 		})
 	}
 
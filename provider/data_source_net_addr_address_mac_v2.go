@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrAddressMacV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing mac address. Version 2 adds support for a mac address assigned from multiple ranges (useful if you get an extra range of mac addresses later on).",
+		Read: dataSourceNetAddrAddressMacV2Read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the address.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_ids": {
+				Description: "Identifiers of the address ranges the address is tied to.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},		
+			},
+			"found_in_range": {
+				Description: "Id of the range the address is in.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"address": {
+				Description: "The address that got assigned to the resource.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrAddressMacV2Read(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrAddressV2Read(d, meta, "mac", address.MacBytesToString)
+}
\ No newline at end of file
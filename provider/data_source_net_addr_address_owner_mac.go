@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrAddressOwnerMac() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the name a mac address is assigned to in a set of ranges.",
+		Read: dataSourceNetAddrAddressOwnerMacRead,
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Description: "The address to look up.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+			"range_ids": {
+				Description: "Identifiers of the address ranges to look for the address in.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"name": {
+				Description: "Name the address is assigned to.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"hardcoded": {
+				Description: "Whether the address is hardcoded (as opposed to generated).",
+				Type:         schema.TypeBool,
+				Computed:     true,
+			},
+			"found_in_range": {
+				Description: "Id of the range the address was found in.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrAddressOwnerMacRead(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrAddressOwnerRead(d, meta, "mac", address.MacStringToBytes, address.MacBytesToString)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetAddrAddressOwnerRead(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+
+	addr, addrErr := parse(d.Get("address").(string))
+	if addrErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address owner: %s", addrErr.Error()))
+	}
+
+	keyPrefixes := GetRangeIdsFromResource(d)
+
+	for _, keyPrefix := range keyPrefixes {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+		if !addrRangeExists {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range does not exist", keyPrefix))
+		}
+		if addrRangeErr != nil {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+		}
+		if addrRange.Type != rangeType {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range type doesn't match", keyPrefix))
+		}
+	}
+
+	found, isHardcoded, name, keyPrefix, ownerErr := conn.FindAddressOwnerInRanges(keyPrefixes, addr)
+	if ownerErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving owner of address '%s': %s", prettify(addr), ownerErr.Error()))
+	}
+	if !found {
+		return errors.New(fmt.Sprintf("Error retrieving owner of address '%s': Address is not assigned in any of the input ranges", prettify(addr)))
+	}
+
+	d.SetId(keyPrefix + prettify(addr))
+	d.Set("name", name)
+	d.Set("hardcoded", isHardcoded)
+	d.Set("found_in_range", keyPrefix)
+
+	return nil
+}
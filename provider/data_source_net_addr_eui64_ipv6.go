@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrEui64Ipv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Computes the ipv6 address a host autoconfigures (SLAAC) in a /64 prefix from its mac address, using the modified EUI-64 interface identifier. The mac address is either passed directly or looked up by name in a mac range.",
+		Read: dataSourceNetAddrEui64Ipv6Read,
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Description: "Ipv6 /64 prefix, in cidr notation, the host autoconfigures its address in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"mac_address": {
+				Description: "Mac address of the host. Either this or name and range_id must be specified. Computed from name and range_id otherwise.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsMACAddress,
+				ExactlyOneOf: []string{"mac_address", "name"},
+			},
+			"name": {
+				Description: "Name of the mac address of the host.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"range_id"},
+			},
+			"range_id": {
+				Description: "Identifier of the mac address range the mac address of the host is tied to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"name"},
+			},
+			"address": {
+				Description: "The ipv6 address the host autoconfigures.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrEui64Ipv6Read(d *schema.ResourceData, meta interface{}) error {
+	prefix, prefixErr := address.Ipv6Prefix64StringToBytes(d.Get("prefix").(string))
+	if prefixErr != nil {
+		return errors.New(fmt.Sprintf("Error computing eui-64 address: %s", prefixErr.Error()))
+	}
+
+	var mac []byte
+	name, nameDefined := d.GetOk("name")
+	if nameDefined {
+		conn := meta.(address.EtcdConnection)
+		keyPrefix := d.Get("range_id").(string)
+
+		addr, _, err := conn.GetAddressWithValidation(name.(string), keyPrefix, "mac", false)
+		if err != nil {
+			return err
+		}
+
+		mac = addr
+	} else {
+		addr, err := address.MacStringToBytes(d.Get("mac_address").(string))
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error computing eui-64 address: %s", err.Error()))
+		}
+
+		mac = addr
+	}
+
+	addr, err := address.MacToEui64Ipv6(prefix, mac)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error computing eui-64 address: %s", err.Error()))
+	}
+
+	d.SetId(address.Ipv6BytesToString(addr))
+	d.Set("mac_address", address.MacBytesToString(mac))
+	d.Set("address", address.Ipv6BytesToString(addr))
+
+	return nil
+}
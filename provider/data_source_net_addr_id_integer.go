@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrIdInteger() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing integer id.",
+		Read: dataSourceNetAddrIdIntegerRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the integer range the id is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"value": {
+				Description: "The id that got assigned to the resource.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrIdIntegerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	keyPrefix := d.Get("range_id").(string)
+
+	value, _, err := conn.GetAddressWithValidation(name, keyPrefix, "integer", false)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	d.Set("value", int(address.IntegerBytesToInt64(value)))
+	
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrNextFreeIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Best-effort preview of the ipv4 address that would be assigned to a new address in a set of ranges. The preview can be wrong if addresses are created or deleted in the ranges before the new address is.",
+		Read: dataSourceNetAddrNextFreeIpv4Read,
+		Schema: map[string]*schema.Schema{
+			"range_ids": {
+				Description: "Identifiers of the address ranges the address would be assigned from, in order of precedence.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"name": {
+				Description: "Name the address would be assigned to. Affects the preview for ranges with the hash_of_name allocation strategy and addresses previously freed under the same name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+			},
+			"address": {
+				Description: "The address that would be assigned.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"found_in_range": {
+				Description: "Id of the range the address would be assigned from.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrNextFreeIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+
+	name := d.Get("name").(string)
+	keyPrefixes := GetRangeIdsFromResource(d)
+
+	addr, keyPrefix, predicted, err := conn.PredictGeneratedAddress(name, keyPrefixes, "ipv4", address.AddressGreaterThan, address.IncAddressBy1)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error previewing next free address: %s", err.Error()))
+	}
+	if !predicted {
+		return errors.New("Error previewing next free address: The ranges are full or one of them has the random allocation strategy")
+	}
+
+	d.SetId(keyPrefix + address.Ipv4BytesToString(addr))
+	d.Set("address", address.Ipv4BytesToString(addr))
+	d.Set("found_in_range", keyPrefix)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrPrefixIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing ipv4 prefix subnets are allocated from.",
+		Read: dataSourceNetAddrPrefixIpv4Read,
+		Schema: map[string]*schema.Schema{
+			"key_prefix": &schema.Schema{
+				Description: "Etcd key prefix for the prefix.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"cidr": {
+				Description: "Parent prefix, in cidr notation, that subnets are allocated from.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"first_address": {
+				Description: "First address of the prefix.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"last_address": {
+				Description: "Last address of the prefix.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrPrefixIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': Prefix does not exist", keyPrefix))
+	}
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if addrRange.Type != "prefix_ipv4" {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': Range type doesn't match", keyPrefix))
+	}
+
+	cidr, cidrErr := address.Ipv4BoundariesToCidr(addrRange.FirstAddress, addrRange.LastAddress)
+	if cidrErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': %s", keyPrefix, cidrErr.Error()))
+	}
+
+	d.SetId(keyPrefix)
+	d.Set("cidr", address.Ipv4CidrBytesToString(cidr))
+	d.Set("first_address", address.Ipv4BytesToString(addrRange.FirstAddress))
+	d.Set("last_address", address.Ipv4BytesToString(addrRange.LastAddress))
+	
+	return nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangeInteger() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing integer id range.",
+		Read: dataSourceNetAddrRangeIntegerRead,
+		Schema: map[string]*schema.Schema{
+			"key_prefix": &schema.Schema{
+				Description: "Etcd key prefix for the range.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"bit_width": {
+				Description: "Number of bits of the ids in the range.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"first_id": {
+				Description: "First assignable id in the range.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"last_id": {
+				Description: "Last assignable id in the range.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated ids in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted id stays in quarantine before it can be assigned to a generated id again.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrRangeIntegerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range does not exist", keyPrefix))
+	}
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if addrRange.Type != "integer" {
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range type doesn't match", keyPrefix))
+	}
+
+	bitWidth, bitWidthErr := GetIntegerRangeBitWidth(addrRange)
+	if bitWidthErr != nil {
+		return bitWidthErr
+	}
+
+	d.SetId(keyPrefix)
+	d.Set("bit_width", bitWidth)
+	d.Set("first_id", int(address.IntegerBytesToInt64(addrRange.FirstAddress)))
+	d.Set("last_id", int(address.IntegerBytesToInt64(addrRange.LastAddress)))
+	d.Set("allocation_strategy", address.GetAllocationStrategy(addrRange))
+
+	reuseDelay, reuseDelayErr := address.GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return reuseDelayErr
+	}
+	d.Set("reuse_delay", int(reuseDelay))
+	
+	return nil
+}
@@ -3,6 +3,9 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -28,10 +31,65 @@ func dataSourceNetAddrRangeIpv4() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed: true,
 			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"cidr": {
+				Description: "Network, in cidr notation, the range was derived from. Empty if the range was not created from a cidr.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"netmask": {
+				Description: "Netmask of the network the range was derived from. Empty if the range was not created from a cidr.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"prefix_length": {
+				Description: "Prefix length of the network the range was derived from. 0 if the range was not created from a cidr.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"gateway": {
+				Description: "Gateway of the network the range is in.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"dns_servers": {
+				Description: "Dns servers of the network the range is in.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"vlan_id": {
+				Description: "Vlan id of the network the range is in. 0 if it is not set.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceNetAddrRangeIpv4Read(d *schema.ResourceData, meta interface{}) error {
-	return dataSourceNetAddrRangeRead(d, meta, "ipv4", address.Ipv4BytesToString)
+	err := dataSourceNetAddrRangeRead(d, meta, "ipv4", address.Ipv4BytesToString)
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(address.EtcdConnection)
+	addrRange, _, addrRangeErr := conn.GetAddrRange(d.Id())
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", d.Id(), addrRangeErr.Error()))
+	}
+
+	setIpv4RangeMetadata(d, addrRange)
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangeIpv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing ipv6 address range.",
+		Read: dataSourceNetAddrRangeIpv6Read,
+		Schema: map[string]*schema.Schema{
+			"key_prefix": &schema.Schema{
+				Description: "Etcd key prefix for address range.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"first_address": {
+				Description: "First assignable address in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"last_address": {
+				Description: "Last assignable address in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrRangeIpv6Read(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrRangeRead(d, meta, "ipv6", address.Ipv6BytesToString)
+}
\ No newline at end of file
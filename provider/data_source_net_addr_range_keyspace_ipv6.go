@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangeKeyspaceIpv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the lower level keyspace details of an ipv6 addresses space. See github repo README for details about the keyspace",
+		Read: dataSourceNetAddrRangeKeyspaceIpv6Read,
+		Schema: map[string]*schema.Schema{
+			"range_id": &schema.Schema{
+				Description: "Identifier of the address range to get the key space from.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"first_address": {
+				Description: "First assignable address in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"last_address": {
+				Description: "Last assignable address in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"next_address": {
+				Description: "Next assignable new address in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"addresses": {
+				Description: "List of all addresses in the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"labels": {
+							Description:  "Labels stored alongside the name of the address",
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"generated_addresses": {
+				Description: "List of all addresses that are flagged as generated in the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"hardcoded_addresses": {
+				Description: "List of all addresses that are flagged as hardcoded in the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"deleted_addresses": {
+				Description: "List of all addresses that were deleted and are available to be reclaimed in the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"quarantined_addresses": {
+				Description: "List of all addresses that were deleted and are waiting for the reuse delay of the range to elapse before they can be reclaimed.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"freed_at": {
+							Description:  "Unix timestamp (in seconds) of when the address was deleted",
+							Type:         schema.TypeInt,
+							Required:     true,
+						},
+					},
+				},
+			},
+			"exclusions": {
+				Description: "List of the excluded sub-ranges of the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_address": {
+							Description:  "First address of the excluded sub-range",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"last_address": {
+							Description:  "Last address of the excluded sub-range",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+
+func dataSourceNetAddrRangeKeyspaceIpv6Read(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrRangeKeyspaceRead(d, meta, "ipv6", address.Ipv6BytesToString)
+}
\ No newline at end of file
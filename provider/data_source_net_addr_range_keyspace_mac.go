@@ -51,6 +51,14 @@ func dataSourceNetAddrRangeKeyspaceMac() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+						"labels": {
+							Description:  "Labels stored alongside the name of the address",
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -117,6 +125,53 @@ func dataSourceNetAddrRangeKeyspaceMac() *schema.Resource {
 					},
 				},
 			},
+			"quarantined_addresses": {
+				Description: "List of all addresses that were deleted and are waiting for the reuse delay of the range to elapse before they can be reclaimed.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  "Name assigned to the adress",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"address": {
+							Description:  "The address",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"freed_at": {
+							Description:  "Unix timestamp (in seconds) of when the address was deleted",
+							Type:         schema.TypeInt,
+							Required:     true,
+						},
+					},
+				},
+			},
+			"exclusions": {
+				Description: "List of the excluded sub-ranges of the range.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_address": {
+							Description:  "First address of the excluded sub-range",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"last_address": {
+							Description:  "Last address of the excluded sub-range",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -32,6 +32,7 @@ func dataSourceNetAddrRangeKeyspaceRead(d *schema.ResourceData, meta interface{}
 		addrSchemaList = append(addrSchemaList, map[string]interface{}{
 			"name": addr.Name,
 			"address": prettify(addr.Address),
+			"labels": keyspace.Labels[addr.Name],
 		})
 	}
 
@@ -74,6 +75,20 @@ func dataSourceNetAddrRangeKeyspaceRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	quarAddrList := keyspace.QuarantinedAddresses
+	sort.SliceStable(quarAddrList, func(i, j int) bool {
+		return quarAddrList[i].Name < quarAddrList[j].Name
+	})
+
+	quarAddrSchemaList := make([]map[string]interface{}, 0)
+	for _, addr := range quarAddrList {
+		quarAddrSchemaList = append(quarAddrSchemaList, map[string]interface{}{
+			"name": addr.Name,
+			"address": prettify(addr.Address),
+			"freed_at": int(addr.FreedAt),
+		})
+	}
+
 	d.SetId(keyPrefix)
 	d.Set("first_address", prettify(keyspace.FirstAddress))
 	d.Set("last_address", prettify(keyspace.LastAddress))
@@ -82,6 +97,8 @@ func dataSourceNetAddrRangeKeyspaceRead(d *schema.ResourceData, meta interface{}
 	d.Set("generated_addresses", genAddrSchemaList)
 	d.Set("hardcoded_addresses", hardAddrSchemaList)
 	d.Set("deleted_addresses", delAddrSchemaList)
+	d.Set("quarantined_addresses", quarAddrSchemaList)
+	d.Set("exclusions", FlattenExclusions(keyspace.Exclusions, prettify))
 	
 	return nil
 }
\ No newline at end of file
@@ -3,6 +3,9 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -28,10 +31,42 @@ func dataSourceNetAddrRangeMac() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed: true,
 			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"oui": {
+				Description: "Organizationally unique identifier all the addresses of the range are prefixed with. Empty if the range was not created from an oui.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"locally_administered_unicast": {
+				Description: "Whether all the addresses of the range must be locally administered unicast addresses.",
+				Type:         schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceNetAddrRangeMacRead(d *schema.ResourceData, meta interface{}) error {
-	return dataSourceNetAddrRangeRead(d, meta, "mac", address.MacBytesToString)
+	err := dataSourceNetAddrRangeRead(d, meta, "mac", address.MacBytesToString)
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(address.EtcdConnection)
+	addrRange, _, addrRangeErr := conn.GetAddrRange(d.Id())
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", d.Id(), addrRangeErr.Error()))
+	}
+
+	setMacRangePolicy(d, addrRange)
+	return nil
 }
\ No newline at end of file
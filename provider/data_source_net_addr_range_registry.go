@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangeRegistry() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the ranges registered in the range registry. Requires the registry_prefix argument of the provider to be set.",
+		Read: dataSourceNetAddrRangeRegistryRead,
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Description: "If set, only ranges of that type (ipv4, ipv6, mac, prefix_ipv4 or integer) are returned.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6", "mac", "prefix_ipv4", "integer"}, false),
+			},
+			"ranges": {
+				Description: "List of registered ranges.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"range_id": {
+							Description:  "Identifier (key prefix) of the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"type": {
+							Description:  "Type of the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"first_address": {
+							Description:  "First address in the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"last_address": {
+							Description:  "Last address in the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrRangeRegistryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	rangeType := d.Get("type").(string)
+
+	registeredRanges, err := conn.GetRegisteredRanges(rangeType)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error retrieving registered ranges: %s", err.Error()))
+	}
+
+	ranges := make([]map[string]interface{}, 0)
+	for _, registeredRange := range registeredRanges {
+		prettify := GetRangeTypePrettify(registeredRange.Type)
+		ranges = append(ranges, map[string]interface{}{
+			"range_id": registeredRange.Prefix,
+			"type": registeredRange.Type,
+			"first_address": prettify(registeredRange.FirstAddress),
+			"last_address": prettify(registeredRange.LastAddress),
+		})
+	}
+
+	d.SetId(conn.RegistryPrefix + rangeType)
+	d.Set("ranges", ranges)
+	return nil
+}
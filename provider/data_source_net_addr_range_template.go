@@ -27,6 +27,13 @@ func dataSourceNetAddrRangeRead(d *schema.ResourceData, meta interface{}, rangeT
 	d.SetId(keyPrefix)
 	d.Set("first_address", prettify(addrRange.FirstAddress))
 	d.Set("last_address", prettify(addrRange.LastAddress))
+	d.Set("allocation_strategy", address.GetAllocationStrategy(addrRange))
+
+	reuseDelay, reuseDelayErr := address.GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return reuseDelayErr
+	}
+	d.Set("reuse_delay", int(reuseDelay))
 	
 	return nil
 }
\ No newline at end of file
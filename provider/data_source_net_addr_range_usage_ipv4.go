@@ -24,15 +24,30 @@ func dataSourceNetAddrRangeUsageIpv4() *schema.Resource {
 				Computed: true,
 			},
 			"used_capacity": {
-				Description: "Number of used addresses in the range.",
+				Description: "Number of used addresses in the range, including quarantined addresses that can't be assigned until their reuse delay elapses.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"excluded_capacity": {
+				Description: "Number of addresses in the excluded sub-ranges of the range.",
 				Type:         schema.TypeInt,
 				Computed: true,
 			},
 			"free_capacity": {
-				Description: "Number of free addresses in the range.",
+				Description: "Number of free addresses in the range (excluded addresses are not free).",
 				Type:         schema.TypeInt,
 				Computed: true,
 			},
+			"exact_capacity": {
+				Description: "Exact number of addresses in the range, as a decimal string. Unlike capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"exact_free_capacity": {
+				Description: "Exact number of free addresses in the range, as a decimal string. Unlike free_capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
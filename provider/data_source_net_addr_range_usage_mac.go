@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangeUsageMac() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves mac addresses utilisation data on an address range.",
+		Read: dataSourceNetAddrRangeUsageMacRead,
+		Schema: map[string]*schema.Schema{
+			"range_id": &schema.Schema{
+				Description: "Identifier of the address range to get the capacity from.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"capacity": {
+				Description: "Number of addresses in the range.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"used_capacity": {
+				Description: "Number of used addresses in the range, including quarantined addresses that can't be assigned until their reuse delay elapses.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"excluded_capacity": {
+				Description: "Number of addresses in the excluded sub-ranges of the range.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"free_capacity": {
+				Description: "Number of free addresses in the range (excluded addresses are not free).",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"exact_capacity": {
+				Description: "Exact number of addresses in the range, as a decimal string. Unlike capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"exact_free_capacity": {
+				Description: "Exact number of free addresses in the range, as a decimal string. Unlike free_capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+
+func dataSourceNetAddrRangeUsageMacRead(d *schema.ResourceData, meta interface{}) error {
+	return dataSourceNetAddrRangeUsageRead(d, meta, "mac", address.MacRangeAddressCount)
+}
\ No newline at end of file
@@ -24,7 +24,7 @@ func dataSourceNetAddrRangeUsageRead(d *schema.ResourceData, meta interface{}, r
 		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range type doesn't match", keyPrefix))
 	}
 
-	usage, usageErr := conn.GetAddrRangeUsage(keyPrefix, address.Ipv4RangeAddressCount)
+	usage, usageErr := conn.GetAddrRangeUsage(keyPrefix, rangeAddrCount)
 	if usageErr != nil {
 		return usageErr
 	}
@@ -32,7 +32,10 @@ func dataSourceNetAddrRangeUsageRead(d *schema.ResourceData, meta interface{}, r
 	d.SetId(keyPrefix)
 	d.Set("capacity", usage.Capacity)
 	d.Set("used_capacity", usage.UsedCapacity)
+	d.Set("excluded_capacity", usage.ExcludedCapacity)
 	d.Set("free_capacity", usage.FreeCapacity)
+	d.Set("exact_capacity", usage.ExactCapacity.String())
+	d.Set("exact_free_capacity", usage.ExactFreeCapacity.String())
 
 	return nil
 }
\ No newline at end of file
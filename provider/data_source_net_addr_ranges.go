@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRanges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Discovers all the ranges (and ipv4 prefixes) whose key prefix is under a root etcd prefix, along with their usage.",
+		Read: dataSourceNetAddrRangesRead,
+		Schema: map[string]*schema.Schema{
+			"root_prefix": &schema.Schema{
+				Description: "Etcd prefix to look for ranges under. All the keys under it are scanned so it should be as specific as possible.",
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"type": &schema.Schema{
+				Description: "If set, only ranges of that type (ipv4, ipv6, mac, prefix_ipv4 or integer) are returned.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6", "mac", "prefix_ipv4", "integer"}, false),
+			},
+			"ranges": {
+				Description: "List of ranges found under the root prefix.",
+				Type:         schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"range_id": {
+							Description:  "Identifier (key prefix) of the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"type": {
+							Description:  "Type of the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"first_address": {
+							Description:  "First address in the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"last_address": {
+							Description:  "Last address in the range.",
+							Type:         schema.TypeString,
+							Computed:     true,
+						},
+						"capacity": {
+							Description: "Number of addresses in the range. Saturates at the maximum signed 64 bits integer value for ranges that are larger (see exact_capacity).",
+							Type:         schema.TypeInt,
+							Computed: true,
+						},
+						"used_capacity": {
+							Description: "Number of used addresses in the range, including quarantined addresses. For ipv4 prefixes, number of addresses in assigned subnets.",
+							Type:         schema.TypeInt,
+							Computed: true,
+						},
+						"excluded_capacity": {
+							Description: "Number of addresses in the excluded sub-ranges of the range.",
+							Type:         schema.TypeInt,
+							Computed: true,
+						},
+						"free_capacity": {
+							Description: "Number of free addresses in the range.",
+							Type:         schema.TypeInt,
+							Computed: true,
+						},
+						"exact_capacity": {
+							Description: "Exact number of addresses in the range, as a decimal string. Unlike capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+							Type:         schema.TypeString,
+							Computed: true,
+						},
+						"exact_free_capacity": {
+							Description: "Exact number of free addresses in the range, as a decimal string. Unlike free_capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+							Type:         schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrRangesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	rootPrefix := d.Get("root_prefix").(string)
+	rangeType := d.Get("type").(string)
+
+	prefixes, prefixesErr := conn.ListAddrRanges(rootPrefix)
+	if prefixesErr != nil {
+		return errors.New(fmt.Sprintf("Error listing ranges under prefix '%s': %s", rootPrefix, prefixesErr.Error()))
+	}
+
+	ranges := make([]map[string]interface{}, 0)
+	for _, prefix := range prefixes {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(prefix)
+		if addrRangeErr != nil {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", prefix, addrRangeErr.Error()))
+		}
+		if !addrRangeExists || (rangeType != "" && addrRange.Type != rangeType) {
+			continue
+		}
+
+		var usage address.AddrRangeUsage
+		var usageErr error
+		if addrRange.Type == "prefix_ipv4" {
+			usage, usageErr = conn.GetSubnetRangeUsage(prefix)
+		} else {
+			usage, usageErr = conn.GetAddrRangeUsage(prefix, GetRangeTypeAddressCount(addrRange.Type))
+		}
+		if usageErr != nil {
+			return errors.New(fmt.Sprintf("Error retrieving usage of address range at prefix '%s': %s", prefix, usageErr.Error()))
+		}
+
+		prettify := GetRangeTypePrettify(addrRange.Type)
+		ranges = append(ranges, map[string]interface{}{
+			"range_id": prefix,
+			"type": addrRange.Type,
+			"first_address": prettify(addrRange.FirstAddress),
+			"last_address": prettify(addrRange.LastAddress),
+			"capacity": usage.Capacity,
+			"used_capacity": usage.UsedCapacity,
+			"excluded_capacity": usage.ExcludedCapacity,
+			"free_capacity": usage.FreeCapacity,
+			"exact_capacity": usage.ExactCapacity.String(),
+			"exact_free_capacity": usage.ExactFreeCapacity.String(),
+		})
+	}
+
+	d.SetId(rootPrefix + rangeType)
+	d.Set("ranges", ranges)
+	return nil
+}
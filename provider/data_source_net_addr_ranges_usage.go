@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrRangesUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves utilisation data summed over several address ranges of the same type, like the ranges a v2 address is allocated from.",
+		Read: dataSourceNetAddrRangesUsageRead,
+		Schema: map[string]*schema.Schema{
+			"range_ids": &schema.Schema{
+				Description: "Identifiers of the address ranges to get the capacity from. They must all have the same type.",
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"type": {
+				Description: "Type of the address ranges.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Description: "Number of addresses in the ranges. Saturates at the maximum signed 64 bits integer value for ranges that are larger (see exact_capacity).",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"used_capacity": {
+				Description: "Number of used addresses in the ranges, including quarantined addresses that can't be assigned until their reuse delay elapses.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"excluded_capacity": {
+				Description: "Number of addresses in the excluded sub-ranges of the ranges.",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"free_capacity": {
+				Description: "Number of free addresses in the ranges (excluded addresses are not free). Derived from the capacity so it is also bounded by the maximum signed 64 bits integer value (see exact_free_capacity).",
+				Type:         schema.TypeInt,
+				Computed: true,
+			},
+			"exact_capacity": {
+				Description: "Exact number of addresses in the ranges, as a decimal string. Unlike capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+			"exact_free_capacity": {
+				Description: "Exact number of free addresses in the ranges, as a decimal string. Unlike free_capacity, it doesn't saturate for ranges larger than the maximum signed 64 bits integer value.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrRangesUsageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefixes := GetRangeIdsFromResource(d)
+	sort.Strings(keyPrefixes)
+
+	rangeType := ""
+	totalUsage := address.AddrRangeUsage{}
+	for _, keyPrefix := range keyPrefixes {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+		if !addrRangeExists {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range does not exist", keyPrefix))
+		}
+		if addrRangeErr != nil {
+			return addrRangeErr
+		}
+		if rangeType != "" && addrRange.Type != rangeType {
+			return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range type '%s' doesn't match the type '%s' of the other ranges", keyPrefix, addrRange.Type, rangeType))
+		}
+		rangeType = addrRange.Type
+
+		var usage address.AddrRangeUsage
+		var usageErr error
+		if addrRange.Type == "prefix_ipv4" {
+			usage, usageErr = conn.GetSubnetRangeUsage(keyPrefix)
+		} else {
+			usage, usageErr = conn.GetAddrRangeUsage(keyPrefix, GetRangeTypeAddressCount(addrRange.Type))
+		}
+		if usageErr != nil {
+			return usageErr
+		}
+
+		totalUsage = totalUsage.Add(usage)
+	}
+
+	d.SetId(strings.Join(keyPrefixes, ","))
+	d.Set("type", rangeType)
+	d.Set("capacity", totalUsage.Capacity)
+	d.Set("used_capacity", totalUsage.UsedCapacity)
+	d.Set("excluded_capacity", totalUsage.ExcludedCapacity)
+	d.Set("free_capacity", totalUsage.FreeCapacity)
+	d.Set("exact_capacity", totalUsage.ExactCapacity.String())
+	d.Set("exact_free_capacity", totalUsage.ExactFreeCapacity.String())
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetAddrSubnetIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves data on an existing ipv4 subnet.",
+		Read: dataSourceNetAddrSubnetIpv4Read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the subnet.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the prefix the subnet is allocated from.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"subnet": {
+				Description: "The subnet, in cidr notation, that got assigned to the resource.",
+				Type:         schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetAddrSubnetIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	keyPrefix := d.Get("range_id").(string)
+
+	subnet, _, err := conn.GetAddressWithValidation(name, keyPrefix, "prefix_ipv4", false)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	d.Set("subnet", address.Ipv4CidrBytesToString(subnet))
+	
+	return nil
+}
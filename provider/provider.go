@@ -83,25 +83,69 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Default:     true,
 			},
+			"max_txn_ops": &schema.Schema{
+				Description: "Maximum number of operations the provider puts in a single etcd transaction. It should match the --max-txn-ops flag of the etcd servers. Resources that would exceed it (large address pools and address blocks) fail with an explicit error instead. Defaults to 128, the etcd default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     128,
+			},
+			"registry_prefix": &schema.Schema{
+				Description: "Etcd key prefix of a registry where all the ranges created or resized by the provider register their boundaries. If set, ranges can't be created or resized to overlap a registered range of the same type, even under a different key prefix. It should be the same for all the terraform projects sharing the same etcd cluster and it should not be under the key prefix of any range. Ranges created while it wasn't set are not registered.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"netaddr_address_ipv4_v2": resourceNetAddrAddressIpv4V2(),
 			"netaddr_address_ipv4": resourceNetAddrAddressIpv4(),
+			"netaddr_address_ipv6_v2": resourceNetAddrAddressIpv6V2(),
+			"netaddr_address_ipv6": resourceNetAddrAddressIpv6(),
 			"netaddr_address_mac": resourceNetAddrAddressMac(),
+			"netaddr_address_mac_v2": resourceNetAddrAddressMacV2(),
+			"netaddr_address_block_ipv4": resourceNetAddrAddressBlockIpv4(),
+			"netaddr_address_block_mac": resourceNetAddrAddressBlockMac(),
+			"netaddr_address_pool_ipv4": resourceNetAddrAddressPoolIpv4(),
+			"netaddr_address_dual_stack": resourceNetAddrAddressDualStack(),
+			"netaddr_interface": resourceNetAddrInterface(),
 			"netaddr_range_ipv4": resourceNetAddrRangeIpv4(),
+			"netaddr_range_ipv6": resourceNetAddrRangeIpv6(),
+			"netaddr_prefix_ipv4": resourceNetAddrPrefixIpv4(),
+			"netaddr_subnet_ipv4": resourceNetAddrSubnetIpv4(),
+			"netaddr_range_integer": resourceNetAddrRangeInteger(),
+			"netaddr_id_integer": resourceNetAddrIdInteger(),
 			"netaddr_range_mac": resourceNetAddrRangeMac(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"netaddr_address_list_ipv4": dataSourceNetAddrAddressListIpv4(),
+			"netaddr_address_list_ipv6": dataSourceNetAddrAddressListIpv6(),
 			"netaddr_address_list_mac": dataSourceNetAddrAddressListMac(),
 			"netaddr_address_ipv4_v2": dataSourceNetAddrAddressIpv4V2(),
 			"netaddr_address_ipv4": dataSourceNetAddrAddressIpv4(),
+			"netaddr_address_ipv6_v2": dataSourceNetAddrAddressIpv6V2(),
+			"netaddr_address_ipv6": dataSourceNetAddrAddressIpv6(),
 			"netaddr_address_mac": dataSourceNetAddrAddressMac(),
+			"netaddr_address_mac_v2": dataSourceNetAddrAddressMacV2(),
+			"netaddr_address_owner_ipv4": dataSourceNetAddrAddressOwnerIpv4(),
+			"netaddr_address_owner_mac": dataSourceNetAddrAddressOwnerMac(),
+			"netaddr_next_free_ipv4": dataSourceNetAddrNextFreeIpv4(),
+			"netaddr_eui64_ipv6": dataSourceNetAddrEui64Ipv6(),
 			"netaddr_range_ipv4": dataSourceNetAddrRangeIpv4(),
+			"netaddr_range_ipv6": dataSourceNetAddrRangeIpv6(),
 			"netaddr_range_mac": dataSourceNetAddrRangeMac(),
 			"netaddr_range_usage_ipv4": dataSourceNetAddrRangeUsageIpv4(),
+			"netaddr_range_usage_ipv6": dataSourceNetAddrRangeUsageIpv6(),
+			"netaddr_range_usage_mac": dataSourceNetAddrRangeUsageMac(),
+			"netaddr_ranges_usage": dataSourceNetAddrRangesUsage(),
 			"netaddr_range_keyspace_ipv4": dataSourceNetAddrRangeKeyspaceIpv4(),
+			"netaddr_range_keyspace_ipv6": dataSourceNetAddrRangeKeyspaceIpv6(),
+			"netaddr_prefix_ipv4": dataSourceNetAddrPrefixIpv4(),
+			"netaddr_subnet_ipv4": dataSourceNetAddrSubnetIpv4(),
+			"netaddr_range_integer": dataSourceNetAddrRangeInteger(),
+			"netaddr_id_integer": dataSourceNetAddrIdInteger(),
 			"netaddr_range_keyspace_mac": dataSourceNetAddrRangeKeyspaceMac(),
+			"netaddr_range_registry": dataSourceNetAddrRangeRegistry(),
+			"netaddr_ranges": dataSourceNetAddrRanges(),
 		},
 		ConfigureFunc: providerConfigure,
 		//Should implement close once this issue is resolved: https://github.com/hashicorp/terraform-plugin-sdk/issues/63
@@ -119,6 +163,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	requestTimeout, _ := d.Get("request_timeout").(int)
 	retries, _ := d.Get("retries").(int)
 	strict, _ := d.Get("strict").(bool)
+	registryPrefix, _ := d.Get("registry_prefix").(string)
+	maxTxnOps, _ := d.Get("max_txn_ops").(int)
 	tlsConf := &tls.Config{}
 
 	if cert != "" {
@@ -160,5 +206,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Timeout: requestTimeout,
 		Retries: retries,
 		Strict: strict,
+		RegistryPrefix: registryPrefix,
+		MaxTxnOps: maxTxnOps,
 	}, nil
 }
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrAddressBlockMac() *schema.Resource {
+	return &schema.Resource{
+		Description: "Block of consecutive generated mac addresses assigned to a single name.",
+		Create: resourceNetAddrAddressBlockMacCreate,
+		Read:   resourceNetAddrAddressBlockMacRead,
+		Delete: resourceNetAddrAddressBlockMacDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with the block.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the address range the block is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"size": {
+				Description: "Number of addresses in the block.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"alignment": {
+				Description: "The first address of the block will be a multiple of this value, which must be a power of 2.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"first_address": {
+				Description: "First address of the block.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"last_address": {
+				Description: "Last address of the block.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"addresses": {
+				Description: "All the addresses of the block, in ascending order.",
+				Type:         schema.TypeList,
+				Computed:     true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetAddrAddressBlockMacCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressBlockCreate(d, meta, "mac", address.MacBytesToString, address.IncAddressBy1)
+}
+
+func resourceNetAddrAddressBlockMacRead(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressBlockRead(d, meta, "mac", address.MacBytesToString)
+}
+
+func resourceNetAddrAddressBlockMacDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressBlockDelete(d, meta, address.MacStringToBytes, address.MacBytesToString)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import(
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetAddrAddressBlockCreate(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr, incAddr address.IncrementAddress) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	keyPrefix := d.Get("range_id").(string)
+	size := d.Get("size").(int)
+	alignment := d.Get("alignment").(int)
+
+	firstAddr, lastAddr, err := conn.CreateAddressBlock(keyPrefix, name, int64(size), int64(alignment), rangeType, incAddr)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Created address block of type '%s', name '%s' and addresses '%s' to '%s' in range '%s'",
+		rangeType,
+		name,
+		prettify(firstAddr),
+		prettify(lastAddr),
+		keyPrefix,
+	))
+
+	d.SetId(name)
+	return resourceNetAddrAddressBlockRead(d, meta, rangeType, prettify)
+}
+
+func resourceNetAddrAddressBlockRead(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	keyPrefix := d.Get("range_id").(string)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range does not exist", keyPrefix))
+	}
+	if addrRange.Type != rangeType {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range type does not match address type", keyPrefix))
+	}
+
+	firstAddr, lastAddr, found, err := conn.GetAddressBlock(keyPrefix, name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if conn.Strict {
+			return errors.New(fmt.Sprintf("Error retrieving address block '%s' in range at prefix '%s': Block was not found in range", name, keyPrefix))
+		}
+
+		log.Printf(fmt.Sprintf(
+			"[WARN] Tried to read non-existent address block of type '%s' and name '%s' in range '%s'",
+			rangeType,
+			name,
+			keyPrefix,
+		))
+
+		d.SetId("")
+		return nil
+	}
+
+	addresses := []string{}
+	size := address.AddressRangeSize(firstAddr, lastAddr)
+	for offset := new(big.Int); offset.Cmp(size) < 0; offset.Add(offset, big.NewInt(1)) {
+		addresses = append(addresses, prettify(address.AddressAtOffset(firstAddr, offset)))
+	}
+
+	d.Set("first_address", prettify(firstAddr))
+	d.Set("last_address", prettify(lastAddr))
+	d.Set("addresses", addresses)
+
+	return nil
+}
+
+func resourceNetAddrAddressBlockDelete(d *schema.ResourceData, meta interface{}, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	keyPrefix := d.Get("range_id").(string)
+
+	firstAddr, firstAddrErr := parse(d.Get("first_address").(string))
+	if firstAddrErr != nil {
+		return firstAddrErr
+	}
+
+	lastAddr, lastAddrErr := parse(d.Get("last_address").(string))
+	if lastAddrErr != nil {
+		return lastAddrErr
+	}
+
+	_, _, found, err := conn.GetAddressBlock(keyPrefix, name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if conn.Strict {
+			return errors.New(fmt.Sprintf("Error deleting address block '%s' in range at prefix '%s': Block was not found in range", name, keyPrefix))
+		}
+
+		log.Printf(fmt.Sprintf(
+			"[WARN] Deleting resource for non-existent address block with name '%s' in range '%s'",
+			name,
+			keyPrefix,
+		))
+		return nil
+	}
+
+	deleteErr := conn.DeleteAddressBlock(keyPrefix, name, firstAddr, lastAddr, prettify)
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Deleted address block with name '%s' and addresses '%s' to '%s' in range '%s'",
+		name,
+		prettify(firstAddr),
+		prettify(lastAddr),
+		keyPrefix,
+	))
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrAddressDualStack() *schema.Resource {
+	return &schema.Resource{
+		Description: "Dual stack ipv4 and ipv6 addresses assigned to the same name, allocated together in a single etcd transaction.",
+		Create: resourceNetAddrAddressDualStackCreate,
+		Read:   resourceNetAddrAddressDualStackRead,
+		Update: resourceNetAddrAddressDualStackUpdate,
+		Delete: resourceNetAddrAddressDualStackDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with both addresses.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ipv4_range_id": {
+				Description: "Identifier of the ipv4 address range the ipv4 address is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ipv6_range_id": {
+				Description: "Identifier of the ipv6 address range the ipv6 address is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"derive_ipv6_from_ipv4": {
+				Description: "Whether to derive the ipv6 address from the generated ipv4 address instead of generating it. The derived ipv6 address is the first address of the ipv6 range with its last 32 bits replaced by the host bits of the ipv4 address (the bits past the prefix length of an ipv4 range created from a cidr, all the bits otherwise). It is then hardcoded in the ipv6 range.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ForceNew:     true,
+			},
+			"ipv4_address": {
+				Description: "The ipv4 address that got assigned to the name.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"ipv6_address": {
+				Description: "The ipv6 address that got assigned to the name.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain both addresses in etcd when the resource is deleted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the addresses are possibly present when the resource is created. Setting this to true allows you to import existing addresses of the name without error, in either or both ranges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrAddressDualStackCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	ipv6KeyPrefix := d.Get("ipv6_range_id").(string)
+	manageExisting, manageExistingDefined := d.GetOk("manage_existing")
+
+	toleratePresent := (manageExistingDefined && manageExisting.(bool)) || (!conn.Strict)
+
+	deriveIpv6 := d.Get("derive_ipv6_from_ipv4").(bool)
+
+	ipv4Addr, ipv6Addr, ipv4Existing, ipv6Existing, err := conn.CreateDualStackAddresses(name, ipv4KeyPrefix, ipv6KeyPrefix, deriveIpv6, toleratePresent)
+	if err != nil {
+		return err
+	}
+
+	if ipv4Existing || ipv6Existing {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Creating dual stack resource for pre-existing addresses with name '%s' (ipv4 address '%s' in range '%s' pre-existing: %t, ipv6 address '%s' in range '%s' pre-existing: %t)",
+			name,
+			address.Ipv4BytesToString(ipv4Addr),
+			ipv4KeyPrefix,
+			ipv4Existing,
+			address.Ipv6BytesToString(ipv6Addr),
+			ipv6KeyPrefix,
+			ipv6Existing,
+		))
+	} else {
+		log.Printf(fmt.Sprintf(
+			"[DEBUG] Created dual stack addresses with name '%s', ipv4 address '%s' in range '%s' and ipv6 address '%s' in range '%s'",
+			name,
+			address.Ipv4BytesToString(ipv4Addr),
+			ipv4KeyPrefix,
+			address.Ipv6BytesToString(ipv6Addr),
+			ipv6KeyPrefix,
+		))
+	}
+
+	d.SetId(name)
+	return resourceNetAddrAddressDualStackRead(d, meta)
+}
+
+func resourceNetAddrAddressDualStackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	ipv6KeyPrefix := d.Get("ipv6_range_id").(string)
+
+	ipv4Addr, ipv4Found, ipv4Err := conn.GetAddressWithValidation(name, ipv4KeyPrefix, "ipv4", !conn.Strict)
+	if ipv4Err != nil {
+		return ipv4Err
+	}
+
+	ipv6Addr, ipv6Found, ipv6Err := conn.GetAddressWithValidation(name, ipv6KeyPrefix, "ipv6", !conn.Strict)
+	if ipv6Err != nil {
+		return ipv6Err
+	}
+
+	if !ipv4Found || !ipv6Found {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Tried to read dual stack addresses with name '%s' missing the ipv4 address in range '%s' (%t) or the ipv6 address in range '%s' (%t)",
+			name,
+			ipv4KeyPrefix,
+			ipv4Found,
+			ipv6KeyPrefix,
+			ipv6Found,
+		))
+
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ipv4_address", address.Ipv4BytesToString(ipv4Addr))
+	d.Set("ipv6_address", address.Ipv6BytesToString(ipv6Addr))
+
+	return nil
+}
+
+func resourceNetAddrAddressDualStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressDualStackRead(d, meta)
+}
+
+func resourceNetAddrAddressDualStackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	ipv6KeyPrefix := d.Get("ipv6_range_id").(string)
+	retain, retainDefined := d.GetOk("retain_on_delete")
+
+	if retainDefined && retain.(bool) {
+		return nil
+	}
+
+	ipv4Addr, ipv4AddrErr := address.Ipv4StringToBytes(d.Get("ipv4_address").(string))
+	if ipv4AddrErr != nil {
+		return ipv4AddrErr
+	}
+
+	ipv6Addr, ipv6AddrErr := address.Ipv6StringToBytes(d.Get("ipv6_address").(string))
+	if ipv6AddrErr != nil {
+		return ipv6AddrErr
+	}
+
+	err := conn.DeleteDualStackAddresses(name, ipv4KeyPrefix, ipv4Addr, ipv6KeyPrefix, ipv6Addr, d.Get("derive_ipv6_from_ipv4").(bool), !conn.Strict)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Deleted dual stack addresses with name '%s', ipv4 address '%s' in range '%s' and ipv6 address '%s' in range '%s'",
+		name,
+		d.Get("ipv4_address").(string),
+		ipv4KeyPrefix,
+		d.Get("ipv6_address").(string),
+		ipv6KeyPrefix,
+	))
+
+	return nil
+}
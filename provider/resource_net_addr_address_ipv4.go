@@ -3,6 +3,8 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,15 +16,15 @@ func resourceNetAddrAddressIpv4() *schema.Resource {
 		Read:   resourceNetAddrAddressIpv4Read,
 		Update: resourceNetAddrAddressIpv4Update,
 		Delete: resourceNetAddrAddressIpv4Delete,
+		CustomizeDiff: resourceNetAddrAddressIpv4CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Name to associate with the address.",
+				Description: "Name to associate with the address. Can be changed without recreating the address, in which case the address is renamed in place (along with its labels) instead of being freed.",
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"range_id": {
@@ -44,6 +46,19 @@ func resourceNetAddrAddressIpv4() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 			},
+			"predicted_address": {
+				Description: "Best-effort prediction, made when the resource is planned for creation, of the address it will be assigned. It is not updated afterwards and can differ from address if other addresses are created or deleted in the range between the plan and the apply. It is set to the assigned address if no prediction could be made (ex: for ranges with the random allocation strategy or ranges that don't exist yet at plan time).",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"labels": {
+				Description: "Arbitrary labels (ex: owner, hostname, environment or ticket) stored alongside the name of the address in etcd. Can be changed without recreating the address.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"retain_on_delete": &schema.Schema{
 				Description: "Whether to retain the address in etcd when the resource is deleted. Useful to set to true if you wish to migrate the address to another terraform project or migrate to the v2 version of the resource.",
 				Type:        schema.TypeBool,
@@ -62,6 +77,10 @@ func resourceNetAddrAddressIpv4() *schema.Resource {
 	}
 }
 
+func resourceNetAddrAddressIpv4CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return resourceNetAddrAddressCustomizeDiff(ctx, d, meta, "ipv4", address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
 func resourceNetAddrAddressIpv4Create(d *schema.ResourceData, meta interface{}) error {
 	return resourceNetAddrAddressCreate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
 }
@@ -71,7 +90,7 @@ func resourceNetAddrAddressIpv4Read(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetAddrAddressIpv4Update(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrAddressRead(d, meta, "ipv4", address.Ipv4BytesToString)
+	return resourceNetAddrAddressUpdate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString)
 }
 
 func resourceNetAddrAddressIpv4Delete(d *schema.ResourceData, meta interface{}) error {
@@ -3,6 +3,8 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,22 +16,21 @@ func resourceNetAddrAddressIpv4V2() *schema.Resource {
 		Read:   resourceNetAddrAddressIpv4V2Read,
 		Update: resourceNetAddrAddressIpv4V2Update,
 		Delete: resourceNetAddrAddressIpv4V2Delete,
+		CustomizeDiff: resourceNetAddrAddressIpv4V2CustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Name to associate with the address.",
+				Description: "Name to associate with the address. Can be changed without recreating the address, in which case the address is renamed in place (along with its labels) instead of being freed.",
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"range_ids": {
-				Description: "Identifiers of the address ranges the address is tied to.",
+				Description: "Identifiers of the address ranges the address is tied to. Can be changed in place as long as the range the address is in remains in the set (see relocate_on_range_removal otherwise).",
 				Type:        schema.TypeSet,
 				Required:    true,
-				ForceNew:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -52,8 +53,28 @@ func resourceNetAddrAddressIpv4V2() *schema.Resource {
 				Type:         schema.TypeString,
 				Computed:     true,
 			},
+			"predicted_address": {
+				Description: "Best-effort prediction, made when the resource is planned for creation, of the address it will be assigned. It is not updated afterwards and can differ from address if other addresses are created or deleted in the range between the plan and the apply. It is set to the assigned address if no prediction could be made (ex: for ranges with the random allocation strategy or ranges that don't exist yet at plan time).",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"labels": {
+				Description: "Arbitrary labels (ex: owner, hostname, environment or ticket) stored alongside the name of the address in etcd. Can be changed without recreating the address.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"retain_on_delete": &schema.Schema{
-				Description: "Whether to retain the address in etcd when the resource is deleted. Useful to set to true if you wish to migrate the address to another terraform project or modify the range_ids set (current range id of the address must be in the new set).",
+				Description: "Whether to retain the address in etcd when the resource is deleted. Useful to set to true if you wish to migrate the address to another terraform project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"relocate_on_range_removal": &schema.Schema{
+				Description: "Whether to move the address to one of the remaining ranges when the range it is in is removed from range_ids. If false, removing that range is an error. Note that a generated address will usually change when relocated.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
@@ -70,6 +91,10 @@ func resourceNetAddrAddressIpv4V2() *schema.Resource {
 	}
 }
 
+func resourceNetAddrAddressIpv4V2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return resourceNetAddrAddressV2CustomizeDiff(ctx, d, meta, "ipv4", address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
 func resourceNetAddrAddressIpv4V2Create(d *schema.ResourceData, meta interface{}) error {
 	return resourceNetAddrAddressV2Create(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
 }
@@ -79,7 +104,7 @@ func resourceNetAddrAddressIpv4V2Read(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetAddrAddressIpv4V2Update(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrAddressV2Read(d, meta, "ipv4", address.Ipv4BytesToString)
+	return resourceNetAddrAddressV2Update(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan, address.AddressLessThan)
 }
 
 func resourceNetAddrAddressIpv4V2Delete(d *schema.ResourceData, meta interface{}) error {
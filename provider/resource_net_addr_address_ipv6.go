@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrAddressIpv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Ipv6 address.",
+		Create: resourceNetAddrAddressIpv6Create,
+		Read:   resourceNetAddrAddressIpv6Read,
+		Update: resourceNetAddrAddressIpv6Update,
+		Delete: resourceNetAddrAddressIpv6Delete,
+		CustomizeDiff: resourceNetAddrAddressIpv6CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with the address. Can be changed without recreating the address, in which case the address is renamed in place (along with its labels) instead of being freed.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the address range the address is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"hardcoded_address": {
+				Description: "An optional input to fixate the address to a specific value.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"address": {
+				Description: "The address that got assigned to the resource.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"predicted_address": {
+				Description: "Best-effort prediction, made when the resource is planned for creation, of the address it will be assigned. It is not updated afterwards and can differ from address if other addresses are created or deleted in the range between the plan and the apply. It is set to the assigned address if no prediction could be made (ex: for ranges with the random allocation strategy or ranges that don't exist yet at plan time).",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"labels": {
+				Description: "Arbitrary labels (ex: owner, hostname, environment or ticket) stored alongside the name of the address in etcd. Can be changed without recreating the address.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain the address in etcd when the resource is deleted. Useful to set to true if you wish to migrate the address to another terraform project or migrate to the v2 version of the resource.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the address is possibly present when the resource is created. Setting this to true allows you to import the existing address without error.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrAddressIpv6CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return resourceNetAddrAddressCustomizeDiff(ctx, d, meta, "ipv6", address.Ipv6BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressIpv6Create(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressCreate(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressIpv6Read(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressRead(d, meta, "ipv6", address.Ipv6BytesToString)
+}
+
+func resourceNetAddrAddressIpv6Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressUpdate(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString)
+}
+
+func resourceNetAddrAddressIpv6Delete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressDelete(d, meta, address.Ipv6StringToBytes, address.Ipv6BytesToString, address.AddressLessThan)
+}
\ No newline at end of file
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrAddressIpv6V2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Ipv6 address. Version 2 adds support for assignment from multiple ranges (useful if you get an extra range of ips from the same subnet later on).",
+		Create: resourceNetAddrAddressIpv6V2Create,
+		Read:   resourceNetAddrAddressIpv6V2Read,
+		Update: resourceNetAddrAddressIpv6V2Update,
+		Delete: resourceNetAddrAddressIpv6V2Delete,
+		CustomizeDiff: resourceNetAddrAddressIpv6V2CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with the address. Can be changed without recreating the address, in which case the address is renamed in place (along with its labels) instead of being freed.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_ids": {
+				Description: "Identifiers of the address ranges the address is tied to. Can be changed in place as long as the range the address is in remains in the set (see relocate_on_range_removal otherwise).",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},		
+			},
+			"found_in_range": {
+				Description: "Id of the range the address is in.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"hardcoded_address": {
+				Description: "An optional input to fixate the address to a specific value.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"address": {
+				Description: "The address that got assigned to the resource.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"predicted_address": {
+				Description: "Best-effort prediction, made when the resource is planned for creation, of the address it will be assigned. It is not updated afterwards and can differ from address if other addresses are created or deleted in the range between the plan and the apply. It is set to the assigned address if no prediction could be made (ex: for ranges with the random allocation strategy or ranges that don't exist yet at plan time).",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"labels": {
+				Description: "Arbitrary labels (ex: owner, hostname, environment or ticket) stored alongside the name of the address in etcd. Can be changed without recreating the address.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain the address in etcd when the resource is deleted. Useful to set to true if you wish to migrate the address to another terraform project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"relocate_on_range_removal": &schema.Schema{
+				Description: "Whether to move the address to one of the remaining ranges when the range it is in is removed from range_ids. If false, removing that range is an error. Note that a generated address will usually change when relocated.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the address is possibly present when the resource is created. Setting this to true allows you to import the existing address without error.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrAddressIpv6V2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return resourceNetAddrAddressV2CustomizeDiff(ctx, d, meta, "ipv6", address.Ipv6BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressIpv6V2Create(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressV2Create(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressIpv6V2Read(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressV2Read(d, meta, "ipv6", address.Ipv6BytesToString)
+}
+
+func resourceNetAddrAddressIpv6V2Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressV2Update(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString, address.IncAddressBy1, address.AddressGreaterThan, address.AddressLessThan)
+}
+
+func resourceNetAddrAddressIpv6V2Delete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressV2Delete(d, meta, address.Ipv6StringToBytes, address.Ipv6BytesToString, address.AddressLessThan)
+}
\ No newline at end of file
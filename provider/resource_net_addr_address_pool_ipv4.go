@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrAddressPoolIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Pool of generated ipv4 addresses allocated together in a single etcd transaction.",
+		Create: resourceNetAddrAddressPoolIpv4Create,
+		Read:   resourceNetAddrAddressPoolIpv4Read,
+		Update: resourceNetAddrAddressPoolIpv4Update,
+		Delete: resourceNetAddrAddressPoolIpv4Delete,
+		CustomizeDiff: resourceNetAddrAddressPoolCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"range_id": {
+				Description: "Identifier of the address range the addresses are tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"names": {
+				Description: "Names to associate with the addresses. Either this or size and name_template must be set.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ExactlyOneOf: []string{"names", "size"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"size": {
+				Description: "Number of addresses in the pool. Their names are generated from name_template.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"name_template"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name_template": {
+				Description: "Template of the names of the addresses when size is set. It must contain a single %d which is replaced by the index of the address, from 0 to size - 1.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"size"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^%]*%d[^%]*$`), "Name template must contain a single %d"),
+			},
+			"addresses": {
+				Description: "Map of the names to the addresses that got assigned to them.",
+				Type:         schema.TypeMap,
+				Computed:     true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetAddrAddressPoolIpv4Create(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressPoolCreate(d, meta, "ipv4", address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressPoolIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressPoolRead(d, meta, "ipv4", address.Ipv4BytesToString)
+}
+
+func resourceNetAddrAddressPoolIpv4Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressPoolUpdate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString, address.IncAddressBy1, address.AddressGreaterThan)
+}
+
+func resourceNetAddrAddressPoolIpv4Delete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrAddressPoolDelete(d, meta, address.Ipv4StringToBytes, address.Ipv4BytesToString)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import(
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//Names of the pool addresses, either listed in names or generated from name_template for indices 0 to size - 1
+func getAddressPoolNames(names *schema.Set, size int, nameTemplate string) []string {
+	poolNames := []string{}
+	if size > 0 {
+		for idx := 0; idx < size; idx++ {
+			poolNames = append(poolNames, fmt.Sprintf(nameTemplate, idx))
+		}
+		return poolNames
+	}
+
+	for _, name := range names.List() {
+		poolNames = append(poolNames, name.(string))
+	}
+
+	sort.Strings(poolNames)
+	return poolNames
+}
+
+func getAddressPoolNamesFromResource(d *schema.ResourceData) []string {
+	return getAddressPoolNames(d.Get("names").(*schema.Set), d.Get("size").(int), d.Get("name_template").(string))
+}
+
+func getAddressPoolAddresses(addresses map[string]interface{}, parse address.ParseAddr) (map[string][]byte, error) {
+	parsedAddresses := map[string][]byte{}
+	for name, addr := range addresses {
+		parsedAddr, err := parse(addr.(string))
+		if err != nil {
+			return map[string][]byte{}, err
+		}
+
+		parsedAddresses[name] = parsedAddr
+	}
+
+	return parsedAddresses, nil
+}
+
+//Forces an update when addresses of the pool went missing or names were added or removed
+func resourceNetAddrAddressPoolCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("names") || !d.NewValueKnown("size") || !d.NewValueKnown("name_template") {
+		return d.SetNewComputed("addresses")
+	}
+
+	poolNames := getAddressPoolNames(d.Get("names").(*schema.Set), d.Get("size").(int), d.Get("name_template").(string))
+	addresses := d.Get("addresses").(map[string]interface{})
+
+	if len(poolNames) != len(addresses) {
+		return d.SetNewComputed("addresses")
+	}
+
+	for _, name := range poolNames {
+		if _, ok := addresses[name]; !ok {
+			return d.SetNewComputed("addresses")
+		}
+	}
+
+	return nil
+}
+
+func resourceNetAddrAddressPoolCreate(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("range_id").(string)
+	poolNames := getAddressPoolNamesFromResource(d)
+
+	addresses, err := conn.CreateGeneratedAddressBatch(keyPrefix, poolNames, rangeType, addrIsGreater, incAddr)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Created %d generated addresses of type '%s' in range '%s'",
+		len(addresses),
+		rangeType,
+		keyPrefix,
+	))
+
+	d.SetId(id.UniqueId())
+	return resourceNetAddrAddressPoolRead(d, meta, rangeType, prettify)
+}
+
+func resourceNetAddrAddressPoolRead(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("range_id").(string)
+	poolNames := getAddressPoolNamesFromResource(d)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if !addrRangeExists {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range does not exist", keyPrefix))
+	}
+	if addrRange.Type != rangeType {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': Range type does not match address type", keyPrefix))
+	}
+
+	listing, listingErr := conn.GetAddressList(keyPrefix)
+	if listingErr != nil {
+		return listingErr
+	}
+
+	assigned := map[string][]byte{}
+	for _, entry := range listing {
+		assigned[entry.Name] = entry.Address
+	}
+
+	addresses := map[string]interface{}{}
+	for _, name := range poolNames {
+		addr, found := assigned[name]
+		if !found {
+			if conn.Strict {
+				return errors.New(fmt.Sprintf("Error retrieving address '%s' in range at prefix '%s': Address was not found in range", name, keyPrefix))
+			}
+
+			log.Printf(fmt.Sprintf(
+				"[WARN] Tried to read non-existent address of type '%s' and name '%s' in range '%s'",
+				rangeType,
+				name,
+				keyPrefix,
+			))
+			continue
+		}
+
+		addresses[name] = prettify(addr)
+	}
+
+	d.Set("addresses", addresses)
+	return nil
+}
+
+/*
+	Addresses of names removed from the pool are freed and addresses are allocated to names added to the pool in a single
+	transaction, so that a failed update leaves the pool as it was in the state
+*/
+func resourceNetAddrAddressPoolUpdate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("range_id").(string)
+	poolNames := getAddressPoolNamesFromResource(d)
+
+	oldAddresses, _ := d.GetChange("addresses")
+	currentAddresses, parseErr := getAddressPoolAddresses(oldAddresses.(map[string]interface{}), parse)
+	if parseErr != nil {
+		return parseErr
+	}
+
+	isPoolName := map[string]bool{}
+	for _, name := range poolNames {
+		isPoolName[name] = true
+	}
+
+	removedAddresses := map[string][]byte{}
+	for name, addr := range currentAddresses {
+		if !isPoolName[name] {
+			removedAddresses[name] = addr
+		}
+	}
+
+	addedNames := []string{}
+	for _, name := range poolNames {
+		if _, ok := currentAddresses[name]; !ok {
+			addedNames = append(addedNames, name)
+		}
+	}
+
+	_, updateErr := conn.UpdateGeneratedAddressBatch(keyPrefix, removedAddresses, addedNames, rangeType, !conn.Strict, addrIsGreater, incAddr)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Updated pool of addresses of type '%s' in range '%s': %d addresses removed and %d addresses added",
+		rangeType,
+		keyPrefix,
+		len(removedAddresses),
+		len(addedNames),
+	))
+
+	return resourceNetAddrAddressPoolRead(d, meta, rangeType, prettify)
+}
+
+func resourceNetAddrAddressPoolDelete(d *schema.ResourceData, meta interface{}, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("range_id").(string)
+
+	addresses, parseErr := getAddressPoolAddresses(d.Get("addresses").(map[string]interface{}), parse)
+	if parseErr != nil {
+		return parseErr
+	}
+
+	deleteErr := conn.DeleteGeneratedAddressBatch(keyPrefix, addresses, !conn.Strict)
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Deleted %d generated addresses in range '%s'",
+		len(addresses),
+		keyPrefix,
+	))
+
+	return nil
+}
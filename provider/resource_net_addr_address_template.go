@@ -3,12 +3,71 @@ package provider
 import(
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+/*
+	Sets predicted_address at plan time to the address the resource will likely get when it is created.
+	It is left unknown if it can't be predicted, in which case it is set to the assigned address at creation.
+*/
+func setPredictedAddress(d *schema.ResourceDiff, meta interface{}, keyPrefixes []string, rangeType string, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
+	conn := meta.(address.EtcdConnection)
+
+	if d.Id() != "" {
+		return nil
+	}
+
+	if hAddr, setAsHardcoded := d.GetOk("hardcoded_address"); setAsHardcoded {
+		if d.NewValueKnown("hardcoded_address") {
+			return d.SetNew("predicted_address", hAddr.(string))
+		}
+
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	addr, prefix, predicted, err := conn.PredictGeneratedAddress(name, keyPrefixes, rangeType, addrIsGreater, incAddr)
+	if err != nil {
+		log.Printf(fmt.Sprintf("[WARN] Failed to predict address of type '%s' and name '%s': %s", rangeType, name, err.Error()))
+		return nil
+	}
+
+	if !predicted {
+		return nil
+	}
+
+	log.Printf(fmt.Sprintf("[DEBUG] Predicted address '%s' in range '%s' for address of type '%s' and name '%s'", prettify(addr), prefix, rangeType, name))
+	return d.SetNew("predicted_address", prettify(addr))
+}
+
+func getAddressLabelsFromResource(d *schema.ResourceData) map[string]string {
+	labels := map[string]string{}
+	for key, val := range d.Get("labels").(map[string]interface{}) {
+		labels[key] = val.(string)
+	}
+
+	return labels
+}
+
+//Sets predicted_address to the assigned address if it couldn't be predicted at plan time
+func setUnpredictedAddress(d *schema.ResourceData) {
+	if d.Get("predicted_address").(string) == "" {
+		d.Set("predicted_address", d.Get("address").(string))
+	}
+}
+
+func resourceNetAddrAddressCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, rangeType string, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
+	if !d.NewValueKnown("range_id") || !d.NewValueKnown("name") {
+		return nil
+	}
+
+	return setPredictedAddress(d, meta, []string{d.Get("range_id").(string)}, rangeType, prettify, incAddr, addrIsGreater)
+}
+
 func resourceNetAddrAddressCreate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
 	conn := meta.(address.EtcdConnection)
 	name := d.Get("name")
@@ -72,7 +131,19 @@ func resourceNetAddrAddressCreate(d *schema.ResourceData, meta interface{}, rang
 	}
 	
 	d.SetId(name.(string))
-	return resourceNetAddrAddressRead(d, meta, rangeType, prettify)
+
+	labelsErr := conn.SetAddressLabels(keyPrefix.(string), name.(string), getAddressLabelsFromResource(d))
+	if labelsErr != nil {
+		return labelsErr
+	}
+
+	readErr := resourceNetAddrAddressRead(d, meta, rangeType, prettify)
+	if readErr != nil || d.Id() == "" {
+		return readErr
+	}
+
+	setUnpredictedAddress(d)
+	return nil
 }
 
 func resourceNetAddrAddressRead(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr) error {
@@ -97,8 +168,14 @@ func resourceNetAddrAddressRead(d *schema.ResourceData, meta interface{}, rangeT
 		return nil
 	}
 
+	labels, labelsErr := conn.GetAddressLabels(keyPrefix.(string), name.(string))
+	if labelsErr != nil {
+		return labelsErr
+	}
+
 	prettyAddr := prettify(addr)
 	d.Set("address", prettyAddr)
+	d.Set("labels", labels)
 
 	log.Printf(fmt.Sprintf(
 		"[DEBUG] Read address of type '%s', name '%s' and address '%s' in range '%s'", 
@@ -111,6 +188,56 @@ func resourceNetAddrAddressRead(d *schema.ResourceData, meta interface{}, rangeT
 	return nil
 }
 
+//Renames the address in place, the id of the resource being its name
+func renameAddress(d *schema.ResourceData, meta interface{}, keyPrefix string, mutExclPrefixes []string, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	oldName, newName := d.GetChange("name")
+
+	prettyAddr, _ := d.GetChange("address")
+	addr, err := parse(prettyAddr.(string))
+	if err != nil {
+		return err
+	}
+
+	renameErr := conn.RenameAddress(keyPrefix, mutExclPrefixes, oldName.(string), newName.(string), addr, prettify)
+	if renameErr != nil {
+		return renameErr
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Renamed address '%s' in range '%s' from '%s' to '%s'",
+		prettyAddr.(string),
+		keyPrefix,
+		oldName.(string),
+		newName.(string),
+	))
+
+	d.SetId(newName.(string))
+	return nil
+}
+
+//Only the name and the labels can be updated, all other arguments force a new address
+func resourceNetAddrAddressUpdate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix := d.Get("range_id").(string)
+
+	if d.HasChange("name") {
+		renameErr := renameAddress(d, meta, keyPrefix, []string{keyPrefix}, parse, prettify)
+		if renameErr != nil {
+			return renameErr
+		}
+	}
+
+	if d.HasChange("labels") {
+		labelsErr := conn.SetAddressLabels(keyPrefix, d.Get("name").(string), getAddressLabelsFromResource(d))
+		if labelsErr != nil {
+			return labelsErr
+		}
+	}
+
+	return resourceNetAddrAddressRead(d, meta, rangeType, prettify)
+}
+
 func resourceNetAddrAddressDelete(d *schema.ResourceData, meta interface{}, parse address.ParseAddr, prettify address.PrettifyAddr, addrIsLess address.AddressIsLess) error {
 	conn := meta.(address.EtcdConnection)
 	name := d.Get("name")
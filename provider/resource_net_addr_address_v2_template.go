@@ -3,6 +3,8 @@ package provider
 import(
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -77,7 +79,19 @@ func resourceNetAddrAddressV2Create(d *schema.ResourceData, meta interface{}, ra
 	}
 	
 	d.SetId(name.(string))
-	return resourceNetAddrAddressV2Read(d, meta, rangeType, prettify)
+
+	labelsErr := conn.SetAddressLabels(d.Get("found_in_range").(string), name.(string), getAddressLabelsFromResource(d))
+	if labelsErr != nil {
+		return labelsErr
+	}
+
+	readErr := resourceNetAddrAddressV2Read(d, meta, rangeType, prettify)
+	if readErr != nil || d.Id() == "" {
+		return readErr
+	}
+
+	setUnpredictedAddress(d)
+	return nil
 }
 
 func resourceNetAddrAddressV2Read(d *schema.ResourceData, meta interface{}, rangeType string, prettify address.PrettifyAddr) error {
@@ -102,8 +116,14 @@ func resourceNetAddrAddressV2Read(d *schema.ResourceData, meta interface{}, rang
 		return nil
 	}
 
+	labels, labelsErr := conn.GetAddressLabels(keyPrefix.(string), name.(string))
+	if labelsErr != nil {
+		return labelsErr
+	}
+
 	prettyAddr := prettify(addr)
 	d.Set("address", prettyAddr)
+	d.Set("labels", labels)
 
 	log.Printf(fmt.Sprintf(
 		"[DEBUG] Read address of type '%s', name '%s' and address '%s' in range '%s'", 
@@ -116,6 +136,139 @@ func resourceNetAddrAddressV2Read(d *schema.ResourceData, meta interface{}, rang
 	return nil
 }
 
+func rangeIdsContain(rangeIds []string, rangeId string) bool {
+	for _, id := range rangeIds {
+		if id == rangeId {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Predicts the address of new addresses (see setPredictedAddress) and checks at plan time that removing the range an
+	existing address is in from range_ids is caught before apply
+*/
+func resourceNetAddrAddressV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, rangeType string, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater) error {
+	if d.Id() == "" {
+		if !d.NewValueKnown("range_ids") || !d.NewValueKnown("name") {
+			return nil
+		}
+
+		return setPredictedAddress(d, meta, GetRangeIdsFromResourceDiff(d), rangeType, prettify, incAddr, addrIsGreater)
+	}
+
+	if !d.HasChange("range_ids") {
+		return nil
+	}
+
+	rangeIds := GetRangeIdsFromResourceDiff(d)
+
+	foundInRange := d.Get("found_in_range").(string)
+	if rangeIdsContain(rangeIds, foundInRange) {
+		return nil
+	}
+
+	if !d.Get("relocate_on_range_removal").(bool) {
+		return errors.New(fmt.Sprintf("Error updating range_ids of address '%s': Range '%s' the address is in was removed. Set relocate_on_range_removal to true to move the address to the remaining ranges", d.Get("name").(string), foundInRange))
+	}
+
+	d.SetNewComputed("found_in_range")
+	if _, setAsHardcoded := d.GetOk("hardcoded_address"); !setAsHardcoded {
+		d.SetNewComputed("address")
+	}
+
+	return nil
+}
+
+func resourceNetAddrAddressV2Update(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr, incAddr address.IncrementAddress, addrIsGreater address.AddressIsGreater, addrIsLess address.AddressIsLess) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix, _ := d.GetChange("found_in_range")
+	keyPrefixes := GetRangeIdsFromResource(d)
+
+	if d.HasChange("name") {
+		mutExclPrefixes := keyPrefixes
+		if !rangeIdsContain(keyPrefixes, keyPrefix.(string)) {
+			mutExclPrefixes = append([]string{keyPrefix.(string)}, keyPrefixes...)
+		}
+
+		renameErr := renameAddress(d, meta, keyPrefix.(string), mutExclPrefixes, parse, prettify)
+		if renameErr != nil {
+			return renameErr
+		}
+	}
+
+	if !d.HasChange("range_ids") || rangeIdsContain(keyPrefixes, keyPrefix.(string)) {
+		if d.HasChange("labels") {
+			labelsErr := conn.SetAddressLabels(keyPrefix.(string), name.(string), getAddressLabelsFromResource(d))
+			if labelsErr != nil {
+				return labelsErr
+			}
+		}
+
+		return resourceNetAddrAddressV2Read(d, meta, rangeType, prettify)
+	}
+
+	relocate, relocateDefined := d.GetOk("relocate_on_range_removal")
+	if !(relocateDefined && relocate.(bool)) {
+		return errors.New(fmt.Sprintf("Error updating range_ids of address '%s': Range '%s' the address is in was removed. Set relocate_on_range_removal to true to move the address to the remaining ranges", name.(string), keyPrefix.(string)))
+	}
+
+	hAddr, setAsHardcoded := d.GetOk("hardcoded_address")
+	manageExisting, manageExistingDefined := d.GetOk("manage_existing")
+	toleratePresent := (manageExistingDefined && manageExisting.(bool)) || (!conn.Strict)
+
+	oldAddr, _ := d.GetChange("address")
+	oldAddrAsBytes, err := parse(oldAddr.(string))
+	if err != nil {
+		return err
+	}
+
+	newPrefix := ""
+	if setAsHardcoded {
+		addrAsBytes, err := parse(hAddr.(string))
+		if err != nil {
+			return err
+		}
+
+		_, prefix, genErr := conn.GenerateHardcodedAddressWithValidation(name.(string), keyPrefixes, addrAsBytes, rangeType, toleratePresent, prettify)
+		if genErr != nil {
+			return genErr
+		}
+		newPrefix = prefix
+	} else {
+		_, _, prefix, genErr := conn.GenerateGeneratedAddressWithValidation(name.(string), keyPrefixes, rangeType, toleratePresent, addrIsGreater, incAddr)
+		if genErr != nil {
+			return genErr
+		}
+		newPrefix = prefix
+	}
+
+	d.Set("found_in_range", newPrefix)
+
+	labelsErr := conn.SetAddressLabels(newPrefix, name.(string), getAddressLabelsFromResource(d))
+	if labelsErr != nil {
+		return labelsErr
+	}
+
+	_, delErr := conn.DeleteAddressWithValidation(name.(string), keyPrefix.(string), setAsHardcoded, oldAddrAsBytes, !conn.Strict, prettify, addrIsLess)
+	if delErr != nil {
+		return delErr
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Relocated address of type '%s' and name '%s' from range '%s' to range '%s'", 
+		rangeType,
+		name.(string),
+		keyPrefix.(string),
+		newPrefix,
+	))
+
+	return resourceNetAddrAddressV2Read(d, meta, rangeType, prettify)
+}
+
 func resourceNetAddrAddressV2Delete(d *schema.ResourceData, meta interface{}, parse address.ParseAddr, prettify address.PrettifyAddr, addrIsLess address.AddressIsLess) error {
 	conn := meta.(address.EtcdConnection)
 	name := d.Get("name")
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrIdInteger() *schema.Resource {
+	return &schema.Resource{
+		Description: "Integer id (vlan id, vni, asn, port, etc).",
+		Create: resourceNetAddrIdIntegerCreate,
+		Read:   resourceNetAddrIdIntegerRead,
+		Update: resourceNetAddrIdIntegerRead,
+		Delete: resourceNetAddrIdIntegerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with the id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the integer range the id is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"hardcoded_value": {
+				Description: "An optional input to fixate the id to a specific value.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"value": {
+				Description: "The id that got assigned to the resource.",
+				Type:         schema.TypeInt,
+				Computed:     true,
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain the id in etcd when the resource is deleted. Useful to set to true if you wish to migrate the id to another terraform project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the id is possibly present when the resource is created. Setting this to true allows you to import the existing id without error.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func getIntegerRangeBitWidthFromEtcd(conn address.EtcdConnection, keyPrefix string) (int, error) {
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if addrRangeErr != nil {
+		return 0, errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if !addrRangeExists {
+		return 0, errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range does not exist", keyPrefix))
+	}
+	if addrRange.Type != "integer" {
+		return 0, errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range type doesn't match", keyPrefix))
+	}
+
+	return GetIntegerRangeBitWidth(addrRange)
+}
+
+func resourceNetAddrIdIntegerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+	hValue, setAsHardcoded := GetOptionalIntFromResource(d, "hardcoded_value")
+	manageExisting, manageExistingDefined := d.GetOk("manage_existing")
+
+	toleratePresent := (manageExistingDefined && manageExisting.(bool)) || (!conn.Strict)
+
+	if setAsHardcoded {
+		bitWidth, bitWidthErr := getIntegerRangeBitWidthFromEtcd(conn, keyPrefix.(string))
+		if bitWidthErr != nil {
+			return bitWidthErr
+		}
+
+		valueAsBytes, err := address.IntegerToBytes(int64(hValue), bitWidth)
+		if err != nil {
+			return err
+		}
+
+		exists, _, genErr := conn.GenerateHardcodedAddressWithValidation(name.(string), []string{keyPrefix.(string)}, valueAsBytes, "integer", toleratePresent, address.IntegerBytesToString)
+		if genErr != nil {
+			return genErr
+		}
+
+		if exists {
+			log.Printf(fmt.Sprintf(
+				"[WARN] Creating resource for pre-existing hardcoded id with name '%s' and value '%d' in range '%s'", 
+				name.(string),
+				hValue,
+				keyPrefix.(string),
+			))
+		} else {
+			log.Printf(fmt.Sprintf(
+				"[DEBUG] Created hardcoded id with name '%s' and value '%d' in range '%s'", 
+				name.(string),
+				hValue,
+				keyPrefix.(string),
+			))
+		}
+	} else {
+		exists, value, _, genErr := conn.GenerateGeneratedAddressWithValidation(name.(string), []string{keyPrefix.(string)}, "integer", toleratePresent, address.AddressGreaterThan, address.IncAddressBy1)
+		if genErr != nil {
+			return genErr
+		}
+
+		if exists {
+			log.Printf(fmt.Sprintf(
+				"[WARN] Creating resource for pre-existing generated id with name '%s' and value '%s' in range '%s'", 
+				name.(string),
+				address.IntegerBytesToString(value),
+				keyPrefix.(string),
+			))
+		} else {
+			log.Printf(fmt.Sprintf(
+				"[DEBUG] Created generated id with name '%s' and value '%s' in range '%s'", 
+				name.(string),
+				address.IntegerBytesToString(value),
+				keyPrefix.(string),
+			))
+		}
+	}
+	
+	d.SetId(name.(string))
+	return resourceNetAddrIdIntegerRead(d, meta)
+}
+
+func resourceNetAddrIdIntegerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+
+	value, found, err := conn.GetAddressWithValidation(name.(string), keyPrefix.(string), "integer", !conn.Strict)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Tried to read non-existent id with name '%s' in range '%s'", 
+			name.(string),
+			keyPrefix.(string),
+		))
+
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("value", int(address.IntegerBytesToInt64(value)))
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Read id with name '%s' and value '%s' in range '%s'", 
+		name.(string),
+		address.IntegerBytesToString(value),
+		keyPrefix.(string),
+	))
+
+	return nil
+}
+
+func resourceNetAddrIdIntegerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+	_, setAsHardcoded := GetOptionalIntFromResource(d, "hardcoded_value")
+	value := d.Get("value")
+	retain, retainDefined := d.GetOk("retain_on_delete")
+
+	if retainDefined && retain.(bool) {
+		return nil
+	}
+
+	bitWidth, bitWidthErr := getIntegerRangeBitWidthFromEtcd(conn, keyPrefix.(string))
+	if bitWidthErr != nil {
+		if !conn.Strict {
+			log.Printf(fmt.Sprintf(
+				"[WARN] Deleting resource for id with name '%s' in range '%s' that could not be retrieved: %s", 
+				name.(string),
+				keyPrefix.(string),
+				bitWidthErr.Error(),
+			))
+			return nil
+		}
+		return bitWidthErr
+	}
+
+	valueAsBytes, err := address.IntegerToBytes(int64(value.(int)), bitWidth)
+	if err != nil {
+		return err
+	}
+
+	exists, err := conn.DeleteAddressWithValidation(name.(string), keyPrefix.(string), setAsHardcoded, valueAsBytes, !conn.Strict, address.IntegerBytesToString, address.AddressLessThan)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Deleting resource for non-existent id with name '%s' and value '%d' in range '%s'", 
+			name.(string),
+			value.(int),
+			keyPrefix.(string),
+		))
+	} else {
+		log.Printf(fmt.Sprintf(
+			"[DEBUG] Deleted id with name '%s' and value '%d' in range '%s'", 
+			name.(string),
+			value.(int),
+			keyPrefix.(string),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Network interface getting a generated ipv4 address and a generated mac address under the same name, allocated together in a single etcd transaction.",
+		Create: resourceNetAddrInterfaceCreate,
+		Read:   resourceNetAddrInterfaceRead,
+		Update: resourceNetAddrInterfaceUpdate,
+		Delete: resourceNetAddrInterfaceDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with both addresses.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ipv4_range_id": {
+				Description: "Identifier of the ipv4 address range the ipv4 address is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"mac_range_id": {
+				Description: "Identifier of the mac address range the mac address is tied to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"ipv4_address": {
+				Description: "The ipv4 address that got assigned to the interface.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"mac_address": {
+				Description: "The mac address that got assigned to the interface.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain both addresses in etcd when the resource is deleted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the addresses are possibly present when the resource is created. Setting this to true allows you to import existing generated addresses of the name without error, in either or both ranges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	macKeyPrefix := d.Get("mac_range_id").(string)
+	manageExisting, manageExistingDefined := d.GetOk("manage_existing")
+
+	toleratePresent := (manageExistingDefined && manageExisting.(bool)) || (!conn.Strict)
+
+	addresses, existing, err := conn.CreateGeneratedAddressesInRanges(
+		[]address.GeneratedAddressRequest{
+			address.GeneratedAddressRequest{Prefix: ipv4KeyPrefix, RangeType: "ipv4", Name: name, AddrIsGreater: address.AddressGreaterThan, IncAddr: address.IncAddressBy1},
+			address.GeneratedAddressRequest{Prefix: macKeyPrefix, RangeType: "mac", Name: name, AddrIsGreater: address.AddressGreaterThan, IncAddr: address.IncAddressBy1},
+		},
+		toleratePresent,
+	)
+	if err != nil {
+		return err
+	}
+
+	if existing[0] || existing[1] {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Creating interface resource for pre-existing addresses with name '%s' (ipv4 address '%s' in range '%s' pre-existing: %t, mac address '%s' in range '%s' pre-existing: %t)",
+			name,
+			address.Ipv4BytesToString(addresses[0]),
+			ipv4KeyPrefix,
+			existing[0],
+			address.MacBytesToString(addresses[1]),
+			macKeyPrefix,
+			existing[1],
+		))
+	} else {
+		log.Printf(fmt.Sprintf(
+			"[DEBUG] Created interface with name '%s', ipv4 address '%s' in range '%s' and mac address '%s' in range '%s'",
+			name,
+			address.Ipv4BytesToString(addresses[0]),
+			ipv4KeyPrefix,
+			address.MacBytesToString(addresses[1]),
+			macKeyPrefix,
+		))
+	}
+
+	d.SetId(name)
+	return resourceNetAddrInterfaceRead(d, meta)
+}
+
+func resourceNetAddrInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	macKeyPrefix := d.Get("mac_range_id").(string)
+
+	ipv4Addr, ipv4Found, ipv4Err := conn.GetAddressWithValidation(name, ipv4KeyPrefix, "ipv4", !conn.Strict)
+	if ipv4Err != nil {
+		return ipv4Err
+	}
+
+	macAddr, macFound, macErr := conn.GetAddressWithValidation(name, macKeyPrefix, "mac", !conn.Strict)
+	if macErr != nil {
+		return macErr
+	}
+
+	if !ipv4Found || !macFound {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Tried to read interface with name '%s' missing its ipv4 address in range '%s' (%t) or its mac address in range '%s' (%t)",
+			name,
+			ipv4KeyPrefix,
+			ipv4Found,
+			macKeyPrefix,
+			macFound,
+		))
+
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ipv4_address", address.Ipv4BytesToString(ipv4Addr))
+	d.Set("mac_address", address.MacBytesToString(macAddr))
+
+	return nil
+}
+
+func resourceNetAddrInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrInterfaceRead(d, meta)
+}
+
+func resourceNetAddrInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name").(string)
+	ipv4KeyPrefix := d.Get("ipv4_range_id").(string)
+	macKeyPrefix := d.Get("mac_range_id").(string)
+	retain, retainDefined := d.GetOk("retain_on_delete")
+
+	if retainDefined && retain.(bool) {
+		return nil
+	}
+
+	ipv4Addr, ipv4AddrErr := address.Ipv4StringToBytes(d.Get("ipv4_address").(string))
+	if ipv4AddrErr != nil {
+		return ipv4AddrErr
+	}
+
+	macAddr, macAddrErr := address.MacStringToBytes(d.Get("mac_address").(string))
+	if macAddrErr != nil {
+		return macAddrErr
+	}
+
+	err := conn.DeleteGeneratedAddressesInRanges(
+		[]address.GeneratedAddressRemoval{
+			address.GeneratedAddressRemoval{Prefix: ipv4KeyPrefix, Name: name, Address: ipv4Addr},
+			address.GeneratedAddressRemoval{Prefix: macKeyPrefix, Name: name, Address: macAddr},
+		},
+		!conn.Strict,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Deleted interface with name '%s', ipv4 address '%s' in range '%s' and mac address '%s' in range '%s'",
+		name,
+		d.Get("ipv4_address").(string),
+		ipv4KeyPrefix,
+		d.Get("mac_address").(string),
+		macKeyPrefix,
+	))
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrPrefixIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Ipv4 prefix to allocate subnets from.",
+		Create: resourceNetAddrPrefixIpv4Create,
+		Read:   resourceNetAddrPrefixIpv4Read,
+		Update: resourceNetAddrPrefixIpv4Update,
+		Delete: resourceNetAddrPrefixIpv4Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetAddrRangeImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"key_prefix": {
+				Description: "Etcd key prefix for all the keys related to the prefix.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"cidr": {
+				Description: "Parent prefix, in cidr notation, that subnets will be allocated from.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"first_address": {
+				Description: "First address of the prefix.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"last_address": {
+				Description: "Last address of the prefix.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"prevent_destroy_if_not_empty": {
+				Description: "If set to true, destroying the prefix fails (listing the names still assigned in it) if it still has assigned subnets. Defaults to true.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+			},
+			"force_destroy": {
+				Description: "If set to true, the prefix is destroyed along with all its assigned subnets, regardless of prevent_destroy_if_not_empty. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrPrefixIpv4Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix, _ := d.GetOk("key_prefix")
+	cidr, _ := d.GetOk("cidr")
+
+	cidrBytes, cidrErr := address.Ipv4CidrStringToBytes(cidr.(string))
+	if cidrErr != nil {
+		return errors.New(fmt.Sprintf("Error creating prefix: %s", cidrErr.Error()))
+	}
+
+	firstAddrBytes, lastAddrBytes := address.Ipv4CidrBoundaries(cidrBytes)
+	addrRange := address.AddressRange{
+		Type: "prefix_ipv4",
+		FirstAddress: firstAddrBytes,
+		LastAddress: lastAddrBytes,
+	}
+
+	if !conn.Strict {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix.(string))
+		if addrRangeErr != nil {
+			return errors.New(fmt.Sprintf("Error retrieving prefix details in non-strict mode: %s", addrRangeErr.Error()))
+		}
+
+		if addrRangeExists {
+			if (!bytes.Equal(firstAddrBytes, addrRange.FirstAddress)) || (!bytes.Equal(lastAddrBytes, addrRange.LastAddress)) {
+				return errors.New(fmt.Sprintf("Error creating prefix in non-strict mode: Pre-existing prefix doesn't match specified prefix"))
+			}
+			d.SetId(keyPrefix.(string))
+			return resourceNetAddrPrefixIpv4Read(d, meta)
+		}
+	}
+
+	creationErr := conn.CreateAddrRange(keyPrefix.(string), addrRange)
+	if creationErr != nil {
+		return errors.New(fmt.Sprintf("Error creating prefix: %s", creationErr.Error()))
+	}
+
+	d.SetId(keyPrefix.(string))
+	return resourceNetAddrPrefixIpv4Read(d, meta)
+}
+
+func resourceNetAddrPrefixIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	keyPrefix := d.Id()
+	conn := meta.(address.EtcdConnection)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if !addrRangeExists {
+		if !conn.Strict {
+			d.SetId("")
+			return nil
+		}
+		
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': Prefix does not exist", keyPrefix))
+	}
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if addrRange.Type != "prefix_ipv4" {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': Range type doesn't match", keyPrefix))
+	}
+
+	cidr, cidrErr := address.Ipv4BoundariesToCidr(addrRange.FirstAddress, addrRange.LastAddress)
+	if cidrErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving prefix at key prefix '%s': %s", keyPrefix, cidrErr.Error()))
+	}
+
+	d.Set("key_prefix", keyPrefix)
+	d.Set("cidr", address.Ipv4CidrBytesToString(cidr))
+	d.Set("first_address", address.Ipv4BytesToString(addrRange.FirstAddress))
+	d.Set("last_address", address.Ipv4BytesToString(addrRange.LastAddress))
+
+	return nil
+}
+
+//Only the destroy settings can be updated and they are not stored in etcd
+func resourceNetAddrPrefixIpv4Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrPrefixIpv4Read(d, meta)
+}
+
+func resourceNetAddrPrefixIpv4Delete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeDelete(d, meta)
+}
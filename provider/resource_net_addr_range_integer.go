@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrRangeInteger() *schema.Resource {
+	return &schema.Resource{
+		Description: "Range to create integer ids (vlan ids, vnis, asns, ports, etc) on.",
+		Create: resourceNetAddrRangeIntegerCreate,
+		Read:   resourceNetAddrRangeIntegerRead,
+		Update: resourceNetAddrRangeIntegerUpdate,
+		Delete: resourceNetAddrRangeIntegerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetAddrRangeImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"key_prefix": {
+				Description: "Etcd key prefix for all the keys related to the range.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"bit_width": {
+				Description: "Number of bits of the ids in the range (ex: 12 for vlan ids, 24 for vxlan vnis, 32 for asns, 16 for ports).",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 63),
+			},
+			"first_id": {
+				Description: "First assignable id in the range.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"last_id": {
+				Description: "Last assignable id in the range.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated ids in the range. Can be 'sequential' (lowest free id first, the default), 'random' (random free id) or 'hash_of_name' (the same name maps to the same id if it is free).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sequential",
+				ValidateFunc: validation.StringInSlice(address.AllocationStrategies, false),
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted id stays in quarantine before it can be assigned to a generated id again. Defaults to 0 (no quarantine).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"prevent_destroy_if_not_empty": {
+				Description: "If set to true, destroying the range fails (listing the names still assigned in it) if it still has assigned ids. Defaults to true.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+			},
+			"force_destroy": {
+				Description: "If set to true, the range is destroyed along with all its assigned ids, regardless of prevent_destroy_if_not_empty. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+			},
+		},
+	}
+}
+
+func GetIntegerRangeBitWidth(addrRange address.AddressRange) (int, error) {
+	bitWidth, err := strconv.Atoi(addrRange.Attributes["bitwidth"])
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Error retrieving bit width of integer range: %s", err.Error()))
+	}
+
+	return bitWidth, nil
+}
+
+func resourceNetAddrRangeIntegerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	keyPrefix, _ := d.GetOk("key_prefix")
+	bitWidth := d.Get("bit_width").(int)
+	allocationStrategy := d.Get("allocation_strategy").(string)
+	reuseDelay := d.Get("reuse_delay").(int)
+
+	firstIdBytes, firstIdErr := address.IntegerToBytes(int64(d.Get("first_id").(int)), bitWidth)
+	if firstIdErr != nil {
+		return errors.New(fmt.Sprintf("Error creating integer range: %s", firstIdErr.Error()))
+	}
+
+	lastIdBytes, lastIdErr := address.IntegerToBytes(int64(d.Get("last_id").(int)), bitWidth)
+	if lastIdErr != nil {
+		return errors.New(fmt.Sprintf("Error creating integer range: %s", lastIdErr.Error()))
+	}
+
+	if address.AddressGreaterThan(firstIdBytes, lastIdBytes) {
+		return errors.New("Error creating integer range: First id is greater than last id")
+	}
+
+	addrRange := address.AddressRange{
+		Type: "integer",
+		FirstAddress: firstIdBytes,
+		LastAddress: lastIdBytes,
+		Attributes: map[string]string{
+			"bitwidth": strconv.Itoa(bitWidth),
+			address.AllocationStrategyAttribute: allocationStrategy,
+			address.ReuseDelayAttribute: strconv.Itoa(reuseDelay),
+		},
+	}
+
+	if !conn.Strict {
+		addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix.(string))
+		if addrRangeErr != nil {
+			return errors.New(fmt.Sprintf("Error retrieving integer range details in non-strict mode: %s", addrRangeErr.Error()))
+		}
+
+		if addrRangeExists {
+			if (!bytes.Equal(firstIdBytes, addrRange.FirstAddress)) || (!bytes.Equal(lastIdBytes, addrRange.LastAddress)) || addrRange.Attributes["bitwidth"] != strconv.Itoa(bitWidth) || address.GetAllocationStrategy(addrRange) != allocationStrategy || !reuseDelayMatches(addrRange, reuseDelay) {
+				return errors.New(fmt.Sprintf("Error creating integer range in non-strict mode: Pre-existing integer range doesn't match specified integer range"))
+			}
+			d.SetId(keyPrefix.(string))
+			return resourceNetAddrRangeIntegerRead(d, meta)
+		}
+	}
+
+	creationErr := conn.CreateAddrRange(keyPrefix.(string), addrRange)
+	if creationErr != nil {
+		return errors.New(fmt.Sprintf("Error creating integer range: %s", creationErr.Error()))
+	}
+
+	d.SetId(keyPrefix.(string))
+	return resourceNetAddrRangeIntegerRead(d, meta)
+}
+
+func resourceNetAddrRangeIntegerRead(d *schema.ResourceData, meta interface{}) error {
+	keyPrefix := d.Id()
+	conn := meta.(address.EtcdConnection)
+
+	addrRange, addrRangeExists, addrRangeErr := conn.GetAddrRange(keyPrefix)
+	if !addrRangeExists {
+		if !conn.Strict {
+			d.SetId("")
+			return nil
+		}
+		
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range does not exist", keyPrefix))
+	}
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': %s", keyPrefix, addrRangeErr.Error()))
+	}
+	if addrRange.Type != "integer" {
+		return errors.New(fmt.Sprintf("Error retrieving integer range at prefix '%s': Range type doesn't match", keyPrefix))
+	}
+
+	bitWidth, bitWidthErr := GetIntegerRangeBitWidth(addrRange)
+	if bitWidthErr != nil {
+		return bitWidthErr
+	}
+
+	d.Set("key_prefix", keyPrefix)
+	d.Set("bit_width", bitWidth)
+	d.Set("first_id", int(address.IntegerBytesToInt64(addrRange.FirstAddress)))
+	d.Set("last_id", int(address.IntegerBytesToInt64(addrRange.LastAddress)))
+	d.Set("allocation_strategy", address.GetAllocationStrategy(addrRange))
+
+	reuseDelay, reuseDelayErr := address.GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return reuseDelayErr
+	}
+	d.Set("reuse_delay", int(reuseDelay))
+
+	return nil
+}
+
+//Only the destroy settings can be updated and they are not stored in etcd
+func resourceNetAddrRangeIntegerUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeIntegerRead(d, meta)
+}
+
+func resourceNetAddrRangeIntegerDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeDelete(d, meta)
+}
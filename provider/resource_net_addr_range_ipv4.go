@@ -3,6 +3,11 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,9 +17,10 @@ func resourceNetAddrRangeIpv4() *schema.Resource {
 		Description: "Address range to create ipv4 addresses on.",
 		Create: resourceNetAddrRangeIpv4Create,
 		Read:   resourceNetAddrRangeIpv4Read,
+		Update: resourceNetAddrRangeIpv4Update,
 		Delete: resourceNetAddrRangeIpv4Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceNetAddrRangeImportState,
 		},
 		Schema: map[string]*schema.Schema{
 			"key_prefix": {
@@ -25,29 +31,228 @@ func resourceNetAddrRangeIpv4() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"first_address": {
-				Description: "First assignable address in the range.",
+				Description: "First assignable address in the range. Either this and last_address or cidr must be specified. Can be changed without recreating the range as long as all assigned addresses remain within it, but it can't be lowered once addresses have been generated in a sequential range.",
 				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"first_address", "cidr"},
+				RequiredWith: []string{"first_address", "last_address"},
 			},
 			"last_address": {
-				Description: "Last assignable address in the range.",
+				Description: "Last assignable address in the range. Either this and first_address or cidr must be specified. Can be changed without recreating the range as long as all assigned addresses remain within it.",
 				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ConflictsWith: []string{"cidr"},
+				RequiredWith: []string{"first_address", "last_address"},
+			},
+			"cidr": {
+				Description: "Network, in cidr notation, to derive the first and last address of the range from.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"exclude_network_and_broadcast": {
+				Description: "Whether to leave the network and broadcast addresses out of the range when it is derived from cidr. Ignored for /31 and /32 networks which have no such addresses.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      true,
+			},
+			"netmask": {
+				Description: "Netmask of the network the range was derived from. Only set if the range was created from cidr.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"prefix_length": {
+				Description: "Prefix length of the network the range was derived from. Only set if the range was created from cidr.",
+				Type:         schema.TypeInt,
+				Computed:     true,
+			},
+			"gateway": {
+				Description: "Gateway of the network, stored as metadata. Note that it is not excluded from the range unless it is outside of it or it is listed in exclusions.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"dns_servers": {
+				Description: "Dns servers of the network, stored as metadata.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"vlan_id": {
+				Description: "Vlan id of the network, stored as metadata.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range. Can be 'sequential' (lowest free address first, the default), 'random' (random free address) or 'hash_of_name' (the same name maps to the same address if it is free).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sequential",
+				ValidateFunc: validation.StringInSlice(address.AllocationStrategies, false),
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again. Defaults to 0 (no quarantine).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"exclusions": {
+				Description: "Sub-ranges of the range that are never assigned (ex: gateway, broadcast or dhcp pool). Set the first and last address to the same value to exclude a single address.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_address": {
+							Description:  "First address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"last_address": {
+							Description:  "Last address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"prevent_destroy_if_not_empty": {
+				Description: "If set to true, destroying the range fails (listing the names still assigned in it) if it still has assigned addresses. Defaults to true.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+			},
+			"force_destroy": {
+				Description: "If set to true, the range is destroyed along with all its assigned addresses, regardless of prevent_destroy_if_not_empty. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
 			},
 		},
 	}
 }
 
+//Network metadata stored in the range attributes. Empty values are for unset metadata
+func getIpv4RangeMetadataFromResource(d *schema.ResourceData) map[string]string {
+	dnsServers := []string{}
+	for _, val := range d.Get("dns_servers").([]interface{}) {
+		dnsServers = append(dnsServers, val.(string))
+	}
+
+	vlanId := ""
+	if d.Get("vlan_id").(int) != 0 {
+		vlanId = strconv.Itoa(d.Get("vlan_id").(int))
+	}
+
+	return map[string]string{
+		"gateway": d.Get("gateway").(string),
+		"dns_servers": strings.Join(dnsServers, ","),
+		"vlan_id": vlanId,
+	}
+}
+
+func setIpv4RangeMetadata(d *schema.ResourceData, addrRange address.AddressRange) {
+	prefixLength, _ := strconv.Atoi(addrRange.Attributes["prefix_length"])
+	vlanId, _ := strconv.Atoi(addrRange.Attributes["vlan_id"])
+	dnsServers := []string{}
+	if addrRange.Attributes["dns_servers"] != "" {
+		dnsServers = strings.Split(addrRange.Attributes["dns_servers"], ",")
+	}
+
+	d.Set("cidr", addrRange.Attributes["cidr"])
+	d.Set("netmask", addrRange.Attributes["netmask"])
+	d.Set("prefix_length", prefixLength)
+	d.Set("gateway", addrRange.Attributes["gateway"])
+	d.Set("dns_servers", dnsServers)
+	d.Set("vlan_id", vlanId)
+}
+
 func resourceNetAddrRangeIpv4Create(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrRangeCreate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString)
+	attributes := map[string]string{}
+	for attrName, attrValue := range getIpv4RangeMetadataFromResource(d) {
+		if attrValue != "" {
+			attributes[attrName] = attrValue
+		}
+	}
+
+	cidr, cidrDefined := d.GetOk("cidr")
+	if cidrDefined {
+		cidrBytes, cidrErr := address.Ipv4CidrStringToBytes(cidr.(string))
+		if cidrErr != nil {
+			return errors.New(fmt.Sprintf("Error creating address range: %s", cidrErr.Error()))
+		}
+
+		firstAddrBytes, lastAddrBytes := address.Ipv4CidrBoundaries(cidrBytes)
+		if d.Get("exclude_network_and_broadcast").(bool) {
+			firstAddrBytes, lastAddrBytes = address.Ipv4CidrHostBoundaries(cidrBytes)
+		}
+
+		d.Set("first_address", address.Ipv4BytesToString(firstAddrBytes))
+		d.Set("last_address", address.Ipv4BytesToString(lastAddrBytes))
+
+		attributes["cidr"] = address.Ipv4CidrBytesToString(cidrBytes)
+		attributes["netmask"] = address.Ipv4CidrNetmask(cidrBytes)
+		attributes["prefix_length"] = strconv.Itoa(int(cidrBytes[len(cidrBytes)-1]))
+	}
+
+	err := resourceNetAddrRangeCreate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString, attributes)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetAddrRangeIpv4Read(d, meta)
 }
 
 func resourceNetAddrRangeIpv4Read(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrRangeRead(d, meta, "ipv4", address.Ipv4BytesToString)
+	err := resourceNetAddrRangeRead(d, meta, "ipv4", address.Ipv4BytesToString)
+	if err != nil || d.Id() == "" {
+		return err
+	}
+
+	conn := meta.(address.EtcdConnection)
+	addrRange, _, addrRangeErr := conn.GetAddrRange(d.Id())
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", d.Id(), addrRangeErr.Error()))
+	}
+
+	setIpv4RangeMetadata(d, addrRange)
+	return nil
+}
+
+//Only the boundaries and the network metadata can be updated, all other arguments force a new range
+func resourceNetAddrRangeIpv4Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+
+	err := resourceNetAddrRangeUpdate(d, meta, "ipv4", address.Ipv4StringToBytes, address.Ipv4BytesToString)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("gateway", "dns_servers", "vlan_id") {
+		err = conn.UpdateAddrRangeAttributes(d.Id(), getIpv4RangeMetadataFromResource(d))
+		if err != nil {
+			return errors.New(fmt.Sprintf("Error updating address range: %s", err.Error()))
+		}
+	}
+
+	return resourceNetAddrRangeIpv4Read(d, meta)
 }
 
 func resourceNetAddrRangeIpv4Delete(d *schema.ResourceData, meta interface{}) error {
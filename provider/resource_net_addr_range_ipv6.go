@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrRangeIpv6() *schema.Resource {
+	return &schema.Resource{
+		Description: "Address range to create ipv6 addresses on.",
+		Create: resourceNetAddrRangeIpv6Create,
+		Read:   resourceNetAddrRangeIpv6Read,
+		Update: resourceNetAddrRangeIpv6Update,
+		Delete: resourceNetAddrRangeIpv6Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetAddrRangeImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"key_prefix": {
+				Description: "Etcd key prefix for all the keys related to the range.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"first_address": {
+				Description: "First assignable address in the range. Can be changed without recreating the range as long as all assigned addresses remain within it, but it can't be lowered once addresses have been generated in a sequential range.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"last_address": {
+				Description: "Last assignable address in the range. Can be changed without recreating the range as long as all assigned addresses remain within it.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range. Can be 'sequential' (lowest free address first, the default), 'random' (random free address) or 'hash_of_name' (the same name maps to the same address if it is free).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sequential",
+				ValidateFunc: validation.StringInSlice(address.AllocationStrategies, false),
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again. Defaults to 0 (no quarantine).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"exclusions": {
+				Description: "Sub-ranges of the range that are never assigned (ex: gateway, broadcast or dhcp pool). Set the first and last address to the same value to exclude a single address.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_address": {
+							Description:  "First address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"last_address": {
+							Description:  "Last address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"prevent_destroy_if_not_empty": {
+				Description: "If set to true, destroying the range fails (listing the names still assigned in it) if it still has assigned addresses. Defaults to true.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+			},
+			"force_destroy": {
+				Description: "If set to true, the range is destroyed along with all its assigned addresses, regardless of prevent_destroy_if_not_empty. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrRangeIpv6Create(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeCreate(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString, nil)
+}
+
+func resourceNetAddrRangeIpv6Read(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeRead(d, meta, "ipv6", address.Ipv6BytesToString)
+}
+
+func resourceNetAddrRangeIpv6Update(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeUpdate(d, meta, "ipv6", address.Ipv6StringToBytes, address.Ipv6BytesToString)
+}
+
+func resourceNetAddrRangeIpv6Delete(d *schema.ResourceData, meta interface{}) error {
+	return resourceNetAddrRangeDelete(d, meta)
+}
\ No newline at end of file
@@ -3,6 +3,9 @@ package provider
 import (
 	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
 
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,9 +15,10 @@ func resourceNetAddrRangeMac() *schema.Resource {
 		Description: "Address range to create mac addresses on.",
 		Create: resourceNetAddrRangeMacCreate,
 		Read:   resourceNetAddrRangeMacRead,
+		Update: resourceNetAddrRangeMacUpdate,
 		Delete: resourceNetAddrRangeMacDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceNetAddrRangeImportState,
 		},
 		Schema: map[string]*schema.Schema{
 			"key_prefix": {
@@ -25,29 +29,150 @@ func resourceNetAddrRangeMac() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"first_address": {
-				Description: "First assignable address in the range.",
+				Description: "First assignable address in the range. Either this and last_address or oui must be specified. Can be changed without recreating the range as long as all assigned addresses remain within it, but it can't be lowered once addresses have been generated in a sequential range.",
 				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"first_address", "oui"},
+				RequiredWith: []string{"first_address", "last_address"},
 			},
 			"last_address": {
-				Description: "Last assignable address in the range.",
+				Description: "Last assignable address in the range. Either this and first_address or oui must be specified. Can be changed without recreating the range as long as all assigned addresses remain within it.",
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ConflictsWith: []string{"oui"},
+				RequiredWith: []string{"first_address", "last_address"},
+			},
+			"oui": {
+				Description: "Organizationally unique identifier (ex: 52:54:00) to derive the first and last address of the range from. The oui must be unicast (I/G bit unset). The range then spans all the addresses prefixed with it and hardcoded addresses must be prefixed with it.",
+				Type:         schema.TypeString,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"locally_administered_unicast": {
+				Description: "If set to true, all the addresses of the range must be locally administered unicast addresses (U/L bit set and I/G bit unset in their first byte). The range boundaries must then share the same first byte and hardcoded addresses that are not locally administered unicast addresses are rejected. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+			},
+			"allocation_strategy": {
+				Description: "Strategy used to pick generated addresses in the range. Can be 'sequential' (lowest free address first, the default), 'random' (random free address) or 'hash_of_name' (the same name maps to the same address if it is free).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sequential",
+				ValidateFunc: validation.StringInSlice(address.AllocationStrategies, false),
+			},
+			"reuse_delay": {
+				Description: "Number of seconds a deleted address stays in quarantine before it can be assigned to a generated address again. Defaults to 0 (no quarantine).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"exclusions": {
+				Description: "Sub-ranges of the range that are never assigned (ex: gateway, broadcast or dhcp pool). Set the first and last address to the same value to exclude a single address.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_address": {
+							Description:  "First address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"last_address": {
+							Description:  "Last address of the excluded sub-range.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			"prevent_destroy_if_not_empty": {
+				Description: "If set to true, destroying the range fails (listing the names still assigned in it) if it still has assigned addresses. Defaults to true.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+			},
+			"force_destroy": {
+				Description: "If set to true, the range is destroyed along with all its assigned addresses, regardless of prevent_destroy_if_not_empty. Defaults to false.",
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+			},
 		},
 	}
 }
 
 func resourceNetAddrRangeMacCreate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrRangeCreate(d, meta, "mac", address.MacStringToBytes, address.MacBytesToString)
+	attributes := map[string]string{}
+
+	oui, ouiDefined := d.GetOk("oui")
+	if ouiDefined {
+		ouiBytes, ouiErr := address.MacOuiStringToBytes(oui.(string))
+		if ouiErr != nil {
+			return errors.New(fmt.Sprintf("Error creating address range: %s", ouiErr.Error()))
+		}
+
+		firstAddrBytes, lastAddrBytes := address.MacOuiBoundaries(ouiBytes)
+		d.Set("first_address", address.MacBytesToString(firstAddrBytes))
+		d.Set("last_address", address.MacBytesToString(lastAddrBytes))
+
+		attributes[address.MacOuiAttribute] = address.MacOuiBytesToString(ouiBytes)
+	}
+
+	if d.Get("locally_administered_unicast").(bool) {
+		attributes[address.MacPolicyAttribute] = address.MacPolicyLocallyAdministeredUnicast
+	}
+
+	err := resourceNetAddrRangeCreate(d, meta, "mac", address.MacStringToBytes, address.MacBytesToString, attributes)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetAddrRangeMacRead(d, meta)
+}
+
+func setMacRangePolicy(d *schema.ResourceData, addrRange address.AddressRange) {
+	d.Set("oui", addrRange.Attributes[address.MacOuiAttribute])
+	d.Set("locally_administered_unicast", address.GetMacPolicy(addrRange) == address.MacPolicyLocallyAdministeredUnicast)
 }
 
 func resourceNetAddrRangeMacRead(d *schema.ResourceData, meta interface{}) error {
-	return resourceNetAddrRangeRead(d, meta, "mac", address.MacBytesToString)
+	err := resourceNetAddrRangeRead(d, meta, "mac", address.MacBytesToString)
+	if err != nil || d.Id() == "" {
+		return err
+	}
+
+	conn := meta.(address.EtcdConnection)
+	addrRange, _, addrRangeErr := conn.GetAddrRange(d.Id())
+	if addrRangeErr != nil {
+		return errors.New(fmt.Sprintf("Error retrieving address range at prefix '%s': %s", d.Id(), addrRangeErr.Error()))
+	}
+
+	setMacRangePolicy(d, addrRange)
+	return nil
+}
+
+func resourceNetAddrRangeMacUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := resourceNetAddrRangeUpdate(d, meta, "mac", address.MacStringToBytes, address.MacBytesToString)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetAddrRangeMacRead(d, meta)
 }
 
 func resourceNetAddrRangeMacDelete(d *schema.ResourceData, meta interface{}) error {
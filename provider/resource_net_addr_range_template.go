@@ -6,11 +6,37 @@ import(
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceNetAddrRangeCreate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+func reuseDelayMatches(addrRange address.AddressRange, reuseDelay int) bool {
+	existingReuseDelay, err := address.GetReuseDelay(addrRange)
+	return err == nil && existingReuseDelay == int64(reuseDelay)
+}
+
+//Ranges of other types than mac have neither attribute, so they always match
+func macPolicyMatches(addrRange address.AddressRange, attributes map[string]string) bool {
+	return addrRange.Attributes[address.MacOuiAttribute] == attributes[address.MacOuiAttribute] && address.GetMacPolicy(addrRange) == attributes[address.MacPolicyAttribute]
+}
+
+func exclusionsMatch(addrRange address.AddressRange, exclusions []address.AddressExclusion) bool {
+	if len(addrRange.Exclusions) != len(exclusions) {
+		return false
+	}
+
+	for _, exclusion := range exclusions {
+		existingExclusion, found := addrRange.GetExclusion(exclusion.FirstAddress)
+		if (!found) || (!bytes.Equal(existingExclusion.FirstAddress, exclusion.FirstAddress)) || (!bytes.Equal(existingExclusion.LastAddress, exclusion.LastAddress)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func resourceNetAddrRangeCreate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr, extraAttributes map[string]string) error {
 	conn := meta.(address.EtcdConnection)
 	keyPrefix, _ := d.GetOk("key_prefix")
 
@@ -26,10 +52,37 @@ func resourceNetAddrRangeCreate(d *schema.ResourceData, meta interface{}, rangeT
 		return errors.New(fmt.Sprintf("Error creating address range: %s", lastAddrErr.Error()))
 	}
 
+	allocationStrategy := d.Get("allocation_strategy").(string)
+	reuseDelay := d.Get("reuse_delay").(int)
+
+	exclusions, exclusionsErr := GetExclusionsFromResource(d, parse)
+	if exclusionsErr != nil {
+		return errors.New(fmt.Sprintf("Error creating address range: %s", exclusionsErr.Error()))
+	}
+
 	addrRange := address.AddressRange{
 		Type: rangeType,
 		FirstAddress: firstAddrBytes,
 		LastAddress: lastAddrBytes,
+		Exclusions: exclusions,
+		Attributes: map[string]string{
+			address.AllocationStrategyAttribute: allocationStrategy,
+			address.ReuseDelayAttribute: strconv.Itoa(reuseDelay),
+		},
+	}
+
+	for attrName, attrValue := range extraAttributes {
+		addrRange.Attributes[attrName] = attrValue
+	}
+
+	validationErr := addrRange.ValidateExclusions(prettify)
+	if validationErr != nil {
+		return errors.New(fmt.Sprintf("Error creating address range: %s", validationErr.Error()))
+	}
+
+	policyErr := addrRange.ValidateMacPolicy(prettify)
+	if policyErr != nil {
+		return errors.New(fmt.Sprintf("Error creating address range: %s", policyErr.Error()))
 	}
 
 	if !conn.Strict {
@@ -39,7 +92,7 @@ func resourceNetAddrRangeCreate(d *schema.ResourceData, meta interface{}, rangeT
 		}
 
 		if addrRangeExists {
-			if (!bytes.Equal(firstAddrBytes, addrRange.FirstAddress)) || (!bytes.Equal(lastAddrBytes, addrRange.LastAddress)) {
+			if (!bytes.Equal(firstAddrBytes, addrRange.FirstAddress)) || (!bytes.Equal(lastAddrBytes, addrRange.LastAddress)) || address.GetAllocationStrategy(addrRange) != allocationStrategy || !reuseDelayMatches(addrRange, reuseDelay) || !exclusionsMatch(addrRange, exclusions) || !macPolicyMatches(addrRange, extraAttributes) {
 				return errors.New(fmt.Sprintf("Error creating address range in non-strict mode: Pre-existing address range doesn't match specified address range"))
 			}
 			d.SetId(keyPrefix.(string))
@@ -79,10 +132,49 @@ func resourceNetAddrRangeRead(d *schema.ResourceData, meta interface{}, rangeTyp
 	d.Set("key_prefix", keyPrefix)
 	d.Set("first_address", prettify(addrRange.FirstAddress))
 	d.Set("last_address", prettify(addrRange.LastAddress))
+	d.Set("allocation_strategy", address.GetAllocationStrategy(addrRange))
+
+	reuseDelay, reuseDelayErr := address.GetReuseDelay(addrRange)
+	if reuseDelayErr != nil {
+		return reuseDelayErr
+	}
+	d.Set("reuse_delay", int(reuseDelay))
+	d.Set("exclusions", FlattenExclusions(addrRange.Exclusions, prettify))
 
 	return nil
 }
 
+//Resizes the range if its boundaries changed, all other arguments force a new range
+func resourceNetAddrRangeUpdate(d *schema.ResourceData, meta interface{}, rangeType string, parse address.ParseAddr, prettify address.PrettifyAddr) error {
+	conn := meta.(address.EtcdConnection)
+
+	if d.HasChanges("first_address", "last_address") {
+		firstAddrBytes, firstAddrErr := parse(d.Get("first_address").(string))
+		if firstAddrErr != nil {
+			return errors.New(fmt.Sprintf("Error updating address range: %s", firstAddrErr.Error()))
+		}
+
+		lastAddrBytes, lastAddrErr := parse(d.Get("last_address").(string))
+		if lastAddrErr != nil {
+			return errors.New(fmt.Sprintf("Error updating address range: %s", lastAddrErr.Error()))
+		}
+
+		resizeErr := conn.ResizeAddrRange(d.Id(), firstAddrBytes, lastAddrBytes, prettify)
+		if resizeErr != nil {
+			return errors.New(fmt.Sprintf("Error updating address range: %s", resizeErr.Error()))
+		}
+	}
+
+	return resourceNetAddrRangeRead(d, meta, rangeType, prettify)
+}
+
+//The destroy settings are not stored in etcd, so imported ranges get their default values
+func resourceNetAddrRangeImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("prevent_destroy_if_not_empty", true)
+	d.Set("force_destroy", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNetAddrRangeDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(address.EtcdConnection)
 	keyPrefix, _ := d.GetOk("key_prefix")
@@ -98,7 +190,12 @@ func resourceNetAddrRangeDelete(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	err := conn.DestroyAddrRange(keyPrefix.(string))
+	var err error
+	if d.Get("prevent_destroy_if_not_empty").(bool) && !d.Get("force_destroy").(bool) {
+		err = conn.DestroyEmptyAddrRange(keyPrefix.(string))
+	} else {
+		err = conn.DestroyAddrRange(keyPrefix.(string))
+	}
 	if err != nil {
 		return errors.New(fmt.Sprintf("Error destroying address range: %s", err.Error()))
 	}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetAddrSubnetIpv4() *schema.Resource {
+	return &schema.Resource{
+		Description: "Ipv4 subnet allocated from an ipv4 prefix.",
+		Create: resourceNetAddrSubnetIpv4Create,
+		Read:   resourceNetAddrSubnetIpv4Read,
+		Update: resourceNetAddrSubnetIpv4Read,
+		Delete: resourceNetAddrSubnetIpv4Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name to associate with the subnet.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"range_id": {
+				Description: "Identifier of the prefix the subnet is allocated from.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"prefix_length": {
+				Description: "Prefix length of the subnet to allocate. Either this or hardcoded_subnet must be specified.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+				ExactlyOneOf: []string{"prefix_length", "hardcoded_subnet"},
+			},
+			"hardcoded_subnet": {
+				Description: "An optional input, in cidr notation, to fixate the subnet to a specific value.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"subnet": {
+				Description: "The subnet, in cidr notation, that got assigned to the resource.",
+				Type:         schema.TypeString,
+				Computed:     true,
+			},
+			"retain_on_delete": &schema.Schema{
+				Description: "Whether to retain the subnet in etcd when the resource is deleted. Useful to set to true if you wish to migrate the subnet to another terraform project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+			"manage_existing": &schema.Schema{
+				Description: "Whether the subnet is possibly present when the resource is created. Setting this to true allows you to import the existing subnet without error.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    false,
+			},
+		},
+	}
+}
+
+func resourceNetAddrSubnetIpv4Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+	hSubnet, setAsHardcoded := d.GetOk("hardcoded_subnet")
+	manageExisting, manageExistingDefined := d.GetOk("manage_existing")
+
+	toleratePresent := (manageExistingDefined && manageExisting.(bool)) || (!conn.Strict)
+
+	if setAsHardcoded {
+		subnetAsBytes, err := address.Ipv4CidrStringToBytes(hSubnet.(string))
+		if err != nil {
+			return err
+		}
+
+		exists, genErr := conn.GenerateHardcodedSubnetWithValidation(name.(string), keyPrefix.(string), "prefix_ipv4", subnetAsBytes, toleratePresent)
+		if genErr != nil {
+			return genErr
+		}
+
+		if exists {
+			log.Printf(fmt.Sprintf(
+				"[WARN] Creating resource for pre-existing hardcoded subnet with name '%s' and subnet '%s' in prefix '%s'", 
+				name.(string),
+				hSubnet.(string),
+				keyPrefix.(string),
+			))
+		} else {
+			log.Printf(fmt.Sprintf(
+				"[DEBUG] Created hardcoded subnet with name '%s' and subnet '%s' in prefix '%s'", 
+				name.(string),
+				hSubnet.(string),
+				keyPrefix.(string),
+			))
+		}
+	} else {
+		prefixLength := d.Get("prefix_length")
+		exists, subnet, genErr := conn.GenerateGeneratedSubnetWithValidation(name.(string), keyPrefix.(string), "prefix_ipv4", prefixLength.(int), toleratePresent)
+		if genErr != nil {
+			return genErr
+		}
+
+		if exists {
+			log.Printf(fmt.Sprintf(
+				"[WARN] Creating resource for pre-existing generated subnet with name '%s' and subnet '%s' in prefix '%s'", 
+				name.(string),
+				address.Ipv4CidrBytesToString(subnet),
+				keyPrefix.(string),
+			))
+		} else {
+			log.Printf(fmt.Sprintf(
+				"[DEBUG] Created generated subnet with name '%s' and subnet '%s' in prefix '%s'", 
+				name.(string),
+				address.Ipv4CidrBytesToString(subnet),
+				keyPrefix.(string),
+			))
+		}
+	}
+	
+	d.SetId(name.(string))
+	return resourceNetAddrSubnetIpv4Read(d, meta)
+}
+
+func resourceNetAddrSubnetIpv4Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+
+	subnet, found, err := conn.GetAddressWithValidation(name.(string), keyPrefix.(string), "prefix_ipv4", !conn.Strict)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Tried to read non-existent subnet with name '%s' in prefix '%s'", 
+			name.(string),
+			keyPrefix.(string),
+		))
+
+		d.SetId("")
+		return nil
+	}
+
+	prettySubnet := address.Ipv4CidrBytesToString(subnet)
+	d.Set("subnet", prettySubnet)
+
+	log.Printf(fmt.Sprintf(
+		"[DEBUG] Read subnet with name '%s' and subnet '%s' in prefix '%s'", 
+		name.(string),
+		prettySubnet,
+		keyPrefix.(string),
+	))
+
+	return nil
+}
+
+func resourceNetAddrSubnetIpv4Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(address.EtcdConnection)
+	name := d.Get("name")
+	keyPrefix := d.Get("range_id")
+	_, setAsHardcoded := d.GetOk("hardcoded_subnet")
+	subnet := d.Get("subnet")
+	retain, retainDefined := d.GetOk("retain_on_delete")
+
+	if retainDefined && retain.(bool) {
+		return nil
+	}
+
+	subnetAsBytes, err := address.Ipv4CidrStringToBytes(subnet.(string))
+	if err != nil {
+		return err
+	}
+
+	exists, err := conn.DeleteSubnetWithValidation(name.(string), keyPrefix.(string), setAsHardcoded, subnetAsBytes, !conn.Strict)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		log.Printf(fmt.Sprintf(
+			"[WARN] Deleting resource for non-existent subnet with name '%s' and subnet '%s' in prefix '%s'", 
+			name.(string),
+			subnet.(string),
+			keyPrefix.(string),
+		))
+	} else {
+		log.Printf(fmt.Sprintf(
+			"[DEBUG] Deleted subnet with name '%s' and subnet '%s' in prefix '%s'", 
+			name.(string),
+			subnet.(string),
+			keyPrefix.(string),
+		))
+	}
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package provider
 
 import (
+	"github.com/Ferlab-Ste-Justine/terraform-provider-netaddr/address"
+
+	"encoding/hex"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,4 +16,98 @@ func GetRangeIdsFromResource(d *schema.ResourceData) []string {
 	}
 
 	return rangeIds
-}
\ No newline at end of file
+}
+
+func GetRangeIdsFromResourceDiff(d *schema.ResourceDiff) []string {
+	keyPrefixes := d.Get("range_ids")
+	rangeIds := []string{}
+	for _, val := range (keyPrefixes.(*schema.Set)).List() {
+		rangeIds = append(rangeIds, val.(string))
+	}
+
+	return rangeIds
+}
+
+//Unlike GetOk, distinguishes an optional integer explicitly set to 0 from an unset one
+func GetOptionalIntFromResource(d *schema.ResourceData, key string) (int, bool) {
+	rawValues := d.GetRawConfig()
+	if rawValues.IsNull() {
+		rawValues = d.GetRawState()
+	}
+
+	if rawValues.IsNull() || rawValues.GetAttr(key).IsNull() {
+		return 0, false
+	}
+
+	return d.Get(key).(int), true
+}
+
+
+func GetExclusionsFromResource(d *schema.ResourceData, parse address.ParseAddr) ([]address.AddressExclusion, error) {
+	exclusions := []address.AddressExclusion{}
+	for _, val := range (d.Get("exclusions").(*schema.Set)).List() {
+		exclusion := val.(map[string]interface{})
+
+		firstAddr, firstAddrErr := parse(exclusion["first_address"].(string))
+		if firstAddrErr != nil {
+			return exclusions, firstAddrErr
+		}
+
+		lastAddr, lastAddrErr := parse(exclusion["last_address"].(string))
+		if lastAddrErr != nil {
+			return exclusions, lastAddrErr
+		}
+
+		exclusions = append(exclusions, address.AddressExclusion{
+			FirstAddress: firstAddr,
+			LastAddress: lastAddr,
+		})
+	}
+
+	return exclusions, nil
+}
+
+func FlattenExclusions(exclusions []address.AddressExclusion, prettify address.PrettifyAddr) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0)
+	for _, exclusion := range exclusions {
+		flattened = append(flattened, map[string]interface{}{
+			"first_address": prettify(exclusion.FirstAddress),
+			"last_address": prettify(exclusion.LastAddress),
+		})
+	}
+
+	return flattened
+}
+
+//Prettify function for the addresses of a given range type, used when ranges of different types are listed together
+func GetRangeTypePrettify(rangeType string) address.PrettifyAddr {
+	switch rangeType {
+	case "ipv4", "prefix_ipv4":
+		return address.Ipv4BytesToString
+	case "ipv6":
+		return address.Ipv6BytesToString
+	case "mac":
+		return address.MacBytesToString
+	case "integer":
+		return address.IntegerBytesToString
+	default:
+		return func(addr []byte) string {
+			return hex.EncodeToString(addr)
+		}
+	}
+}
+
+//Address count function for a given range type, used when ranges of different types are listed together
+func GetRangeTypeAddressCount(rangeType string) address.RangeAddressCount {
+	switch rangeType {
+	case "ipv4", "prefix_ipv4":
+		return address.Ipv4RangeAddressCount
+	case "integer":
+		return address.IntegerRangeAddressCount
+	case "mac":
+		return address.MacRangeAddressCount
+	default:
+		//The ipv6 count works with addresses of any length
+		return address.Ipv6RangeAddressCount
+	}
+}
@@ -0,0 +1,59 @@
+//Address Blocks
+resource "netaddr_range_ipv4" "block_ipv4" {
+    key_prefix = "/test/block-ipv4/"
+    first_address = "192.168.40.1"
+    last_address = "192.168.40.254"
+}
+
+resource "netaddr_address_ipv4" "block_ipv4_addr1" {
+    range_id = netaddr_range_ipv4.block_ipv4.id
+    name = "addr1"
+}
+
+resource "netaddr_address_ipv4" "block_ipv4_hardcoded" {
+    range_id = netaddr_range_ipv4.block_ipv4.id
+    name = "hardcoded"
+    hardcoded_address = "192.168.40.10"
+}
+
+resource "netaddr_address_block_ipv4" "block_ipv4_vips" {
+    range_id = netaddr_range_ipv4.block_ipv4.id
+    name = "vips"
+    size = 8
+    alignment = 8
+    depends_on = [
+        netaddr_address_ipv4.block_ipv4_addr1,
+        netaddr_address_ipv4.block_ipv4_hardcoded,
+    ]
+}
+
+resource "netaddr_address_ipv4" "block_ipv4_addr2" {
+    range_id = netaddr_range_ipv4.block_ipv4.id
+    name = "addr2"
+    depends_on = [netaddr_address_block_ipv4.block_ipv4_vips]
+}
+
+resource "netaddr_range_mac" "block_mac" {
+    key_prefix = "/test/block-mac/"
+    first_address = "52:54:01:00:00:00"
+    last_address = "52:54:01:ff:ff:ff"
+}
+
+resource "netaddr_address_block_mac" "block_mac_vfs" {
+    range_id = netaddr_range_mac.block_mac.id
+    name = "vfs"
+    size = 16
+    alignment = 16
+}
+
+output "block_ipv4_vips" {
+  value = netaddr_address_block_ipv4.block_ipv4_vips.addresses
+}
+
+output "block_ipv4_addr2" {
+  value = netaddr_address_ipv4.block_ipv4_addr2.address
+}
+
+output "block_mac_vfs" {
+  value = "${netaddr_address_block_mac.block_mac_vfs.first_address} - ${netaddr_address_block_mac.block_mac_vfs.last_address}"
+}
\ No newline at end of file
@@ -0,0 +1,17 @@
+data "netaddr_address_owner_ipv4" "exclusions_ipv4_addr2" {
+    address = netaddr_address_ipv4.exclusions_ipv4_addr2.address
+    range_ids = [netaddr_range_ipv4.exclusions_ipv4.id]
+}
+
+data "netaddr_address_owner_mac" "multirange_mac_addr4" {
+    address = netaddr_address_mac_v2.multirange_mac_addr4.address
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+}
+
+output "exclusions_ipv4_addr2_owner" {
+  value = data.netaddr_address_owner_ipv4.exclusions_ipv4_addr2
+}
+
+output "multirange_mac_addr4_owner" {
+  value = data.netaddr_address_owner_mac.multirange_mac_addr4
+}
\ No newline at end of file
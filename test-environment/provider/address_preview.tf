@@ -0,0 +1,20 @@
+data "netaddr_next_free_ipv4" "basic_ipv4_next" {
+    range_ids = [netaddr_range_ipv4.basic_ipv4.id]
+    depends_on = [
+        netaddr_address_ipv4.basic_ipv4_addr1,
+        netaddr_address_ipv4.basic_ipv4_addr2,
+    ]
+}
+
+resource "netaddr_address_ipv4" "basic_ipv4_preview" {
+    range_id = netaddr_range_ipv4.basic_ipv4.id
+    name = "preview"
+}
+
+output "basic_ipv4_next" {
+  value = data.netaddr_next_free_ipv4.basic_ipv4_next.address
+}
+
+output "basic_ipv4_preview" {
+  value = "predicted: ${netaddr_address_ipv4.basic_ipv4_preview.predicted_address}, assigned: ${netaddr_address_ipv4.basic_ipv4_preview.address}"
+}
\ No newline at end of file
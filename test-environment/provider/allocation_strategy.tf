@@ -0,0 +1,43 @@
+//Allocation strategies
+resource "netaddr_range_mac" "hashed_mac" {
+    key_prefix = "/test/hashed-mac/"
+    first_address = "52:54:03:00:00:00"
+    last_address = "52:54:03:ff:ff:ff"
+    allocation_strategy = "hash_of_name"
+}
+
+resource "netaddr_address_mac" "hashed_mac_addr1" {
+    range_id = netaddr_range_mac.hashed_mac.id
+    name = "addr1"
+}
+
+resource "netaddr_address_mac" "hashed_mac_addr2" {
+    range_id = netaddr_range_mac.hashed_mac.id
+    name = "addr2"
+}
+
+resource "netaddr_range_ipv4" "random_ipv4" {
+    key_prefix = "/test/random-ipv4/"
+    first_address = "192.171.0.1"
+    last_address = "192.171.0.4"
+    allocation_strategy = "random"
+}
+
+resource "netaddr_address_ipv4" "random_ipv4_addr1" {
+    range_id = netaddr_range_ipv4.random_ipv4.id
+    name = "addr1"
+}
+
+resource "netaddr_address_ipv4" "random_ipv4_addr2" {
+    range_id = netaddr_range_ipv4.random_ipv4.id
+    name = "addr2"
+    hardcoded_address = "192.171.0.2"
+}
+
+output "hashed_mac_addr1" {
+  value = netaddr_address_mac.hashed_mac_addr1.address
+}
+
+output "random_ipv4_addr1" {
+  value = netaddr_address_ipv4.random_ipv4_addr1.address
+}
\ No newline at end of file
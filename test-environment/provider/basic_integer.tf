@@ -0,0 +1,37 @@
+//Integer Validation
+resource "netaddr_range_integer" "basic_integer" {
+    key_prefix = "/test/basic-integer/"
+    bit_width = 8
+    first_id = 0
+    last_id = 255
+}
+
+resource "netaddr_id_integer" "basic_integer_id1" {
+    range_id = netaddr_range_integer.basic_integer.id
+    name = "id1"
+    hardcoded_value = 0
+}
+
+resource "netaddr_id_integer" "basic_integer_id2" {
+    range_id = netaddr_range_integer.basic_integer.id
+    name = "id2"
+    depends_on = [netaddr_id_integer.basic_integer_id1]
+}
+
+resource "netaddr_id_integer" "basic_integer_id3" {
+    range_id = netaddr_range_integer.basic_integer.id
+    name = "id3"
+    hardcoded_value = 255
+}
+
+output "basic_integer_id1" {
+  value = netaddr_id_integer.basic_integer_id1.value
+}
+
+output "basic_integer_id2" {
+  value = netaddr_id_integer.basic_integer_id2.value
+}
+
+output "basic_integer_id3" {
+  value = netaddr_id_integer.basic_integer_id3.value
+}
\ No newline at end of file
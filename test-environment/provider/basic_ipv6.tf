@@ -0,0 +1,64 @@
+//Ipv6 Validation
+resource "netaddr_range_ipv6" "basic_ipv6" {
+    key_prefix = "/test/basic-ipv6/"
+    first_address = "fd00:10:128::1"
+    last_address = "fd00:10:128::1:ffff"
+}
+
+resource "netaddr_address_ipv6" "basic_ipv6_addr1" {
+    range_id = netaddr_range_ipv6.basic_ipv6.id
+    name = "addr1"
+    hardcoded_address = "fd00:10:128::2"
+}
+
+resource "netaddr_address_ipv6" "basic_ipv6_addr2" {
+    range_id = netaddr_range_ipv6.basic_ipv6.id
+    name = "addr2"
+    depends_on = [netaddr_address_ipv6.basic_ipv6_addr1]
+}
+
+resource "netaddr_address_ipv6" "basic_ipv6_addr3" {
+    range_id = netaddr_range_ipv6.basic_ipv6.id
+    name = "addr3"
+    depends_on = [netaddr_address_ipv6.basic_ipv6_addr1]
+}
+
+resource "netaddr_range_ipv6" "basic_ipv6_range2" {
+    key_prefix = "/test/basic-ipv6-range2/"
+    first_address = "fd00:10:129::1"
+    last_address = "fd00:10:129::2"
+}
+
+resource "netaddr_address_ipv6_v2" "basic_ipv6_v2_addr1" {
+    range_ids = [netaddr_range_ipv6.basic_ipv6_range2.id, netaddr_range_ipv6.basic_ipv6.id]
+    name = "v2addr1"
+}
+
+data "netaddr_range_usage_ipv6" "basic_ipv6" {
+  range_id = netaddr_range_ipv6.basic_ipv6.id
+  depends_on = [
+    netaddr_address_ipv6.basic_ipv6_addr2,
+    netaddr_address_ipv6.basic_ipv6_addr3,
+    netaddr_address_ipv6_v2.basic_ipv6_v2_addr1,
+  ]
+}
+
+output "basic_ipv6_addr1" {
+  value = netaddr_address_ipv6.basic_ipv6_addr1.address
+}
+
+output "basic_ipv6_addr2" {
+  value = netaddr_address_ipv6.basic_ipv6_addr2.address
+}
+
+output "basic_ipv6_addr3" {
+  value = netaddr_address_ipv6.basic_ipv6_addr3.address
+}
+
+output "basic_ipv6_v2_addr1" {
+  value = netaddr_address_ipv6_v2.basic_ipv6_v2_addr1
+}
+
+output "basic_ipv6_usage" {
+  value = data.netaddr_range_usage_ipv6.basic_ipv6
+}
\ No newline at end of file
@@ -0,0 +1,42 @@
+resource "netaddr_range_ipv4" "cidr" {
+    key_prefix = "/test/cidr-ipv4/"
+    cidr = "192.174.0.0/28"
+    gateway = "192.174.0.1"
+    dns_servers = ["192.174.0.2"]
+    vlan_id = 174
+
+    exclusions {
+        first_address = "192.174.0.1"
+        last_address = "192.174.0.2"
+    }
+}
+
+resource "netaddr_range_ipv4" "cidr_whole_network" {
+    key_prefix = "/test/cidr-whole-network-ipv4/"
+    cidr = "192.174.1.0/28"
+    exclude_network_and_broadcast = false
+}
+
+resource "netaddr_address_ipv4" "cidr_addr1" {
+    range_id = netaddr_range_ipv4.cidr.id
+    name = "addr1"
+}
+
+data "netaddr_range_ipv4" "cidr" {
+    key_prefix = netaddr_range_ipv4.cidr.id
+}
+
+output "cidr_addr1" {
+  value = netaddr_address_ipv4.cidr_addr1.address
+}
+
+output "cidr_range" {
+  value = data.netaddr_range_ipv4.cidr
+}
+
+output "cidr_whole_network_range" {
+  value = {
+    first_address = netaddr_range_ipv4.cidr_whole_network.first_address
+    last_address = netaddr_range_ipv4.cidr_whole_network.last_address
+  }
+}
\ No newline at end of file
@@ -0,0 +1,32 @@
+//Dual stack addresses
+resource "netaddr_range_ipv4" "dual_stack_ipv4" {
+    key_prefix = "/test/dual-stack-ipv4/"
+    cidr = "192.168.60.0/24"
+}
+
+resource "netaddr_range_ipv6" "dual_stack_ipv6" {
+    key_prefix = "/test/dual-stack-ipv6/"
+    first_address = "fd00:60::"
+    last_address = "fd00:60::ffff"
+}
+
+resource "netaddr_address_dual_stack" "dual_stack_node1" {
+    name = "node1"
+    ipv4_range_id = netaddr_range_ipv4.dual_stack_ipv4.id
+    ipv6_range_id = netaddr_range_ipv6.dual_stack_ipv6.id
+}
+
+resource "netaddr_address_dual_stack" "dual_stack_node2" {
+    name = "node2"
+    ipv4_range_id = netaddr_range_ipv4.dual_stack_ipv4.id
+    ipv6_range_id = netaddr_range_ipv6.dual_stack_ipv6.id
+    derive_ipv6_from_ipv4 = true
+}
+
+output "dual_stack_node1" {
+  value = "${netaddr_address_dual_stack.dual_stack_node1.ipv4_address} / ${netaddr_address_dual_stack.dual_stack_node1.ipv6_address}"
+}
+
+output "dual_stack_node2" {
+  value = "${netaddr_address_dual_stack.dual_stack_node2.ipv4_address} / ${netaddr_address_dual_stack.dual_stack_node2.ipv6_address}"
+}
\ No newline at end of file
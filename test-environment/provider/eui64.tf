@@ -0,0 +1,20 @@
+//Eui-64 addresses
+data "netaddr_eui64_ipv6" "basic_mac_addr1" {
+    prefix = "fd00:70:1:2::/64"
+    name = netaddr_address_mac.basic_mac_addr1.name
+    range_id = netaddr_range_mac.basic_mac.id
+    depends_on = [netaddr_address_mac.basic_mac_addr1]
+}
+
+data "netaddr_eui64_ipv6" "basic_mac_addr2" {
+    prefix = "fd00:70:1:2::/64"
+    mac_address = netaddr_address_mac.basic_mac_addr2.address
+}
+
+output "basic_mac_addr1_eui64" {
+  value = data.netaddr_eui64_ipv6.basic_mac_addr1.address
+}
+
+output "basic_mac_addr2_eui64" {
+  value = data.netaddr_eui64_ipv6.basic_mac_addr2.address
+}
\ No newline at end of file
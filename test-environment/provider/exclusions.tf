@@ -0,0 +1,64 @@
+resource "netaddr_range_ipv4" "exclusions_ipv4" {
+    key_prefix = "/test/exclusions-ipv4/"
+    first_address = "192.173.0.1"
+    last_address = "192.173.0.10"
+
+    exclusions {
+        first_address = "192.173.0.1"
+        last_address = "192.173.0.1"
+    }
+
+    exclusions {
+        first_address = "192.173.0.3"
+        last_address = "192.173.0.8"
+    }
+}
+
+resource "netaddr_address_ipv4" "exclusions_ipv4_addr1" {
+    range_id = netaddr_range_ipv4.exclusions_ipv4.id
+    name = "addr1"
+}
+
+resource "netaddr_address_ipv4" "exclusions_ipv4_addr2" {
+    range_id = netaddr_range_ipv4.exclusions_ipv4.id
+    name = "addr2"
+    depends_on = [netaddr_address_ipv4.exclusions_ipv4_addr1]
+}
+
+resource "netaddr_range_mac" "exclusions_mac" {
+    key_prefix = "/test/exclusions-mac/"
+    first_address = "52:54:04:00:00:00"
+    last_address = "52:54:04:00:00:ff"
+
+    exclusions {
+        first_address = "52:54:04:00:00:00"
+        last_address = "52:54:04:00:00:0f"
+    }
+}
+
+resource "netaddr_address_mac" "exclusions_mac_addr1" {
+    range_id = netaddr_range_mac.exclusions_mac.id
+    name = "addr1"
+}
+
+data "netaddr_range_usage_ipv4" "exclusions_ipv4" {
+    range_id = netaddr_range_ipv4.exclusions_ipv4.id
+    depends_on = [netaddr_address_ipv4.exclusions_ipv4_addr2]
+}
+
+data "netaddr_range_keyspace_mac" "exclusions_mac" {
+    range_id = netaddr_range_mac.exclusions_mac.id
+    depends_on = [netaddr_address_mac.exclusions_mac_addr1]
+}
+
+output "exclusions_ipv4_addr2" {
+  value = netaddr_address_ipv4.exclusions_ipv4_addr2.address
+}
+
+output "exclusions_ipv4_usage" {
+  value = data.netaddr_range_usage_ipv4.exclusions_ipv4
+}
+
+output "exclusions_mac_keyspace" {
+  value = data.netaddr_range_keyspace_mac.exclusions_mac
+}
\ No newline at end of file
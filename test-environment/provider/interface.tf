@@ -0,0 +1,32 @@
+//Interfaces
+resource "netaddr_range_ipv4" "interface_ipv4" {
+    key_prefix = "/test/interface-ipv4/"
+    first_address = "192.168.50.1"
+    last_address = "192.168.50.254"
+}
+
+resource "netaddr_range_mac" "interface_mac" {
+    key_prefix = "/test/interface-mac/"
+    first_address = "52:54:02:00:00:00"
+    last_address = "52:54:02:ff:ff:ff"
+}
+
+resource "netaddr_interface" "interface_vm1" {
+    name = "vm1"
+    ipv4_range_id = netaddr_range_ipv4.interface_ipv4.id
+    mac_range_id = netaddr_range_mac.interface_mac.id
+}
+
+resource "netaddr_interface" "interface_vm2" {
+    name = "vm2"
+    ipv4_range_id = netaddr_range_ipv4.interface_ipv4.id
+    mac_range_id = netaddr_range_mac.interface_mac.id
+}
+
+output "interface_vm1" {
+  value = "${netaddr_interface.interface_vm1.ipv4_address} / ${netaddr_interface.interface_vm1.mac_address}"
+}
+
+output "interface_vm2" {
+  value = "${netaddr_interface.interface_vm2.ipv4_address} / ${netaddr_interface.interface_vm2.mac_address}"
+}
\ No newline at end of file
@@ -0,0 +1,35 @@
+//Labels
+resource "netaddr_range_ipv4" "labels_ipv4" {
+    key_prefix = "/test/labels-ipv4/"
+    first_address = "192.168.70.1"
+    last_address = "192.168.70.254"
+}
+
+resource "netaddr_address_ipv4" "labels_ipv4_addr1" {
+    range_id = netaddr_range_ipv4.labels_ipv4.id
+    name = "addr1"
+    labels = {
+        owner = "platform"
+        environment = "qa"
+    }
+}
+
+resource "netaddr_address_ipv4_v2" "labels_ipv4_addr2" {
+    range_ids = [netaddr_range_ipv4.labels_ipv4.id]
+    name = "addr2"
+    labels = {
+        hostname = "addr2.example.com"
+    }
+}
+
+data "netaddr_address_list_ipv4" "labels_ipv4" {
+    range_id = netaddr_range_ipv4.labels_ipv4.id
+    depends_on = [
+        netaddr_address_ipv4.labels_ipv4_addr1,
+        netaddr_address_ipv4_v2.labels_ipv4_addr2,
+    ]
+}
+
+output "labels_ipv4" {
+  value = data.netaddr_address_list_ipv4.labels_ipv4.addresses
+}
\ No newline at end of file
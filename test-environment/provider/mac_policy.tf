@@ -0,0 +1,40 @@
+//Mac policies
+resource "netaddr_range_mac" "mac_policy_oui" {
+    key_prefix = "/test/mac-policy-oui/"
+    oui = "52:54:05"
+}
+
+resource "netaddr_range_mac" "mac_policy_local" {
+    key_prefix = "/test/mac-policy-local/"
+    first_address = "06:00:00:00:00:00"
+    last_address = "06:00:00:00:ff:ff"
+    locally_administered_unicast = true
+}
+
+resource "netaddr_address_mac" "mac_policy_oui_addr1" {
+    range_id = netaddr_range_mac.mac_policy_oui.id
+    name = "addr1"
+}
+
+resource "netaddr_address_mac" "mac_policy_local_addr1" {
+    range_id = netaddr_range_mac.mac_policy_local.id
+    name = "addr1"
+}
+
+resource "netaddr_address_mac" "mac_policy_local_addr2" {
+    range_id = netaddr_range_mac.mac_policy_local.id
+    name = "addr2"
+    hardcoded_address = "06:00:00:00:00:10"
+}
+
+output "mac_policy_oui_addr1" {
+  value = netaddr_address_mac.mac_policy_oui_addr1.address
+}
+
+output "mac_policy_local_addr1" {
+  value = netaddr_address_mac.mac_policy_local_addr1.address
+}
+
+output "mac_policy_local_addr2" {
+  value = netaddr_address_mac.mac_policy_local_addr2.address
+}
\ No newline at end of file
@@ -0,0 +1,45 @@
+resource "netaddr_range_mac" "multirange_mac" {
+    key_prefix = "/test/multirange-mac/"
+    first_address = "52:54:02:00:00:01"
+    last_address = "52:54:02:00:00:02"
+}
+
+resource "netaddr_range_mac" "multirange_mac_range2" {
+    key_prefix = "/test/multirange-mac-range2/"
+    first_address = "52:54:02:00:00:10"
+    last_address = "52:54:02:00:00:20"
+}
+
+resource "netaddr_address_mac_v2" "multirange_mac_addr1" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    name = "addr1"
+}
+
+resource "netaddr_address_mac_v2" "multirange_mac_addr2" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    name = "addr2"
+}
+
+resource "netaddr_address_mac_v2" "multirange_mac_addr3" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    name = "addr3"
+}
+
+resource "netaddr_address_mac_v2" "multirange_mac_addr4" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    name = "addr4"
+    hardcoded_address = "52:54:02:00:00:20"
+}
+
+data "netaddr_address_mac_v2" "multirange_mac_addr3" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    name = resource.netaddr_address_mac_v2.multirange_mac_addr3.name
+}
+
+output "multirange_mac_addr3" {
+  value = netaddr_address_mac_v2.multirange_mac_addr3
+}
+
+output "multirange_mac_addr3_data" {
+  value = data.netaddr_address_mac_v2.multirange_mac_addr3
+}
\ No newline at end of file
@@ -0,0 +1,31 @@
+//Ipv4 Pool
+resource "netaddr_range_ipv4" "pool_ipv4" {
+    key_prefix = "/test/pool-ipv4/"
+    first_address = "192.168.30.1"
+    last_address = "192.168.30.254"
+}
+
+resource "netaddr_address_ipv4" "pool_ipv4_single" {
+    range_id = netaddr_range_ipv4.pool_ipv4.id
+    name = "single"
+}
+
+resource "netaddr_address_pool_ipv4" "pool_ipv4_workers" {
+    range_id = netaddr_range_ipv4.pool_ipv4.id
+    size = 20
+    name_template = "worker-%d"
+    depends_on = [netaddr_address_ipv4.pool_ipv4_single]
+}
+
+resource "netaddr_address_pool_ipv4" "pool_ipv4_masters" {
+    range_id = netaddr_range_ipv4.pool_ipv4.id
+    names = ["master-1", "master-2", "master-3"]
+}
+
+output "pool_ipv4_workers" {
+  value = netaddr_address_pool_ipv4.pool_ipv4_workers.addresses
+}
+
+output "pool_ipv4_masters" {
+  value = netaddr_address_pool_ipv4.pool_ipv4_masters.addresses
+}
\ No newline at end of file
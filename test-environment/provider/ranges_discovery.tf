@@ -0,0 +1,14 @@
+data "netaddr_ranges" "all" {
+    root_prefix = "/test/"
+    depends_on = [
+        netaddr_range_ipv4.basic_ipv4,
+        netaddr_range_ipv6.basic_ipv6,
+        netaddr_range_mac.basic_mac,
+        netaddr_range_integer.basic_integer,
+        netaddr_prefix_ipv4.subnet_ipv4,
+    ]
+}
+
+output "discovered_ranges" {
+  value = data.netaddr_ranges.all.ranges
+}
\ No newline at end of file
@@ -0,0 +1,27 @@
+data "netaddr_range_usage_mac" "multirange_mac" {
+    range_id = netaddr_range_mac.multirange_mac.id
+    depends_on = [
+        netaddr_address_mac_v2.multirange_mac_addr1,
+        netaddr_address_mac_v2.multirange_mac_addr2,
+        netaddr_address_mac_v2.multirange_mac_addr3,
+        netaddr_address_mac_v2.multirange_mac_addr4,
+    ]
+}
+
+data "netaddr_ranges_usage" "multirange_mac" {
+    range_ids = [netaddr_range_mac.multirange_mac.id, netaddr_range_mac.multirange_mac_range2.id]
+    depends_on = [
+        netaddr_address_mac_v2.multirange_mac_addr1,
+        netaddr_address_mac_v2.multirange_mac_addr2,
+        netaddr_address_mac_v2.multirange_mac_addr3,
+        netaddr_address_mac_v2.multirange_mac_addr4,
+    ]
+}
+
+output "multirange_mac_usage" {
+  value = data.netaddr_range_usage_mac.multirange_mac
+}
+
+output "multirange_mac_total_usage" {
+  value = data.netaddr_ranges_usage.multirange_mac
+}
\ No newline at end of file
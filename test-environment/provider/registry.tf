@@ -0,0 +1,35 @@
+provider "netaddr" {
+  alias = "registry"
+  endpoints = "127.0.0.1:32379"
+  ca_cert = "${path.module}/../server/certs/ca.pem"
+  cert = "${path.module}/../server/certs/root.pem"
+  key = "${path.module}/../server/certs/root.key"
+  registry_prefix = "/test/registry/"
+}
+
+resource "netaddr_range_ipv4" "registry_first" {
+    provider = netaddr.registry
+    key_prefix = "/test/registry-first-ipv4/"
+    first_address = "192.176.0.1"
+    last_address = "192.176.0.100"
+}
+
+resource "netaddr_range_ipv4" "registry_second" {
+    provider = netaddr.registry
+    key_prefix = "/test/registry-second-ipv4/"
+    first_address = "192.176.0.101"
+    last_address = "192.176.0.200"
+}
+
+data "netaddr_range_registry" "ipv4" {
+    provider = netaddr.registry
+    type = "ipv4"
+    depends_on = [
+        netaddr_range_ipv4.registry_first,
+        netaddr_range_ipv4.registry_second,
+    ]
+}
+
+output "registry_ipv4" {
+  value = data.netaddr_range_registry.ipv4.ranges
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+variable "resize_last_address" {
+  type = string
+  default = "192.175.0.5"
+}
+
+resource "netaddr_range_ipv4" "resize" {
+    key_prefix = "/test/resize-ipv4/"
+    first_address = "192.175.0.1"
+    last_address = var.resize_last_address
+}
+
+resource "netaddr_address_ipv4" "resize_addr1" {
+    range_id = netaddr_range_ipv4.resize.id
+    name = "addr1"
+}
+
+resource "netaddr_address_ipv4" "resize_addr2" {
+    range_id = netaddr_range_ipv4.resize.id
+    name = "addr2"
+    depends_on = [netaddr_address_ipv4.resize_addr1]
+}
+
+data "netaddr_range_usage_ipv4" "resize" {
+    range_id = netaddr_range_ipv4.resize.id
+    depends_on = [
+        netaddr_range_ipv4.resize,
+        netaddr_address_ipv4.resize_addr2,
+    ]
+}
+
+output "resize_usage" {
+  value = data.netaddr_range_usage_ipv4.resize
+}
\ No newline at end of file
@@ -0,0 +1,20 @@
+resource "netaddr_range_ipv4" "quarantine_ipv4" {
+    key_prefix = "/test/quarantine-ipv4/"
+    first_address = "192.172.0.1"
+    last_address = "192.172.0.4"
+    reuse_delay = 3600
+}
+
+resource "netaddr_address_ipv4" "quarantine_ipv4_addr1" {
+    range_id = netaddr_range_ipv4.quarantine_ipv4.id
+    name = "addr1"
+}
+
+data "netaddr_range_keyspace_ipv4" "quarantine_ipv4" {
+    range_id = netaddr_range_ipv4.quarantine_ipv4.id
+    depends_on = [netaddr_address_ipv4.quarantine_ipv4_addr1]
+}
+
+output "quarantine_ipv4_keyspace" {
+  value = data.netaddr_range_keyspace_ipv4.quarantine_ipv4
+}
\ No newline at end of file
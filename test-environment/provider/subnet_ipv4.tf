@@ -0,0 +1,48 @@
+//Ipv4 Subnet Validation
+resource "netaddr_prefix_ipv4" "subnet_ipv4" {
+    key_prefix = "/test/subnet-ipv4/"
+    cidr = "10.10.0.0/22"
+}
+
+resource "netaddr_subnet_ipv4" "subnet_ipv4_hardcoded" {
+    range_id = netaddr_prefix_ipv4.subnet_ipv4.id
+    name = "hardcoded"
+    hardcoded_subnet = "10.10.1.0/24"
+}
+
+resource "netaddr_subnet_ipv4" "subnet_ipv4_small" {
+    range_id = netaddr_prefix_ipv4.subnet_ipv4.id
+    name = "small"
+    prefix_length = 28
+    depends_on = [netaddr_subnet_ipv4.subnet_ipv4_hardcoded]
+}
+
+resource "netaddr_subnet_ipv4" "subnet_ipv4_large" {
+    range_id = netaddr_prefix_ipv4.subnet_ipv4.id
+    name = "large"
+    prefix_length = 24
+    depends_on = [netaddr_subnet_ipv4.subnet_ipv4_small]
+}
+
+resource "netaddr_subnet_ipv4" "subnet_ipv4_small2" {
+    range_id = netaddr_prefix_ipv4.subnet_ipv4.id
+    name = "small2"
+    prefix_length = 27
+    depends_on = [netaddr_subnet_ipv4.subnet_ipv4_large]
+}
+
+output "subnet_ipv4_hardcoded" {
+  value = netaddr_subnet_ipv4.subnet_ipv4_hardcoded.subnet
+}
+
+output "subnet_ipv4_small" {
+  value = netaddr_subnet_ipv4.subnet_ipv4_small.subnet
+}
+
+output "subnet_ipv4_large" {
+  value = netaddr_subnet_ipv4.subnet_ipv4_large.subnet
+}
+
+output "subnet_ipv4_small2" {
+  value = netaddr_subnet_ipv4.subnet_ipv4_small2.subnet
+}
\ No newline at end of file